@@ -0,0 +1,388 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"net/http"
+	"strings"
+)
+
+type Api map[string]any
+
+// APIDefinition is the typed form of a classic API definition, the
+// APIDefinition schema of the gateway. Nested objects the provider does not
+// look into are kept as raw JSON.
+type APIDefinition struct {
+	ID                                   string                `json:"id,omitempty"`
+	Name                                 string                `json:"name,omitempty"`
+	Expiration                           string                `json:"expiration,omitempty"`
+	Slug                                 string                `json:"slug,omitempty"`
+	ListenPort                           int                   `json:"listen_port,omitempty"`
+	Protocol                             string                `json:"protocol,omitempty"`
+	EnableProxyProtocol                  bool                  `json:"enable_proxy_protocol,omitempty"`
+	APIID                                string                `json:"api_id,omitempty"`
+	OrgID                                string                `json:"org_id,omitempty"`
+	UseKeylessAccess                     bool                  `json:"use_keyless,omitempty"`
+	UseOauth2                            bool                  `json:"use_oauth2,omitempty"`
+	ExternalOAuth                        json.RawMessage       `json:"external_oauth,omitempty"`
+	UseOpenID                            bool                  `json:"use_openid,omitempty"`
+	OpenIDOptions                        json.RawMessage       `json:"openid_options,omitempty"`
+	Oauth2Meta                           json.RawMessage       `json:"oauth_meta,omitempty"`
+	Auth                                 *AuthConfig           `json:"auth,omitempty"`
+	AuthConfigs                          map[string]AuthConfig `json:"auth_configs,omitempty"`
+	UseBasicAuth                         bool                  `json:"use_basic_auth,omitempty"`
+	BasicAuth                            json.RawMessage       `json:"basic_auth,omitempty"`
+	UseMutualTLSAuth                     bool                  `json:"use_mutual_tls_auth,omitempty"`
+	ClientCertificates                   []string              `json:"client_certificates,omitempty"`
+	UpstreamCertificates                 map[string]string     `json:"upstream_certificates,omitempty"`
+	UpstreamCertificatesDisabled         bool                  `json:"upstream_certificates_disabled,omitempty"`
+	PinnedPublicKeys                     map[string]string     `json:"pinned_public_keys,omitempty"`
+	CertificatePinningDisabled           bool                  `json:"certificate_pinning_disabled,omitempty"`
+	EnableJWT                            bool                  `json:"enable_jwt,omitempty"`
+	UseStandardAuth                      bool                  `json:"use_standard_auth,omitempty"`
+	UseGoPluginAuth                      bool                  `json:"use_go_plugin_auth,omitempty"`
+	EnableCoProcessAuth                  bool                  `json:"enable_coprocess_auth,omitempty"`
+	CustomPluginAuthEnabled              bool                  `json:"custom_plugin_auth_enabled,omitempty"`
+	JWTSigningMethod                     string                `json:"jwt_signing_method,omitempty"`
+	JWTSource                            string                `json:"jwt_source,omitempty"`
+	JWTIdentityBaseField                 string                `json:"jwt_identity_base_field,omitempty"`
+	JWTClientIDBaseField                 string                `json:"jwt_client_base_field,omitempty"`
+	JWTPolicyFieldName                   string                `json:"jwt_policy_field_name,omitempty"`
+	JWTDefaultPolicies                   []string              `json:"jwt_default_policies,omitempty"`
+	JWTIssuedAtValidationSkew            int64                 `json:"jwt_issued_at_validation_skew,omitempty"`
+	JWTExpiresAtValidationSkew           int64                 `json:"jwt_expires_at_validation_skew,omitempty"`
+	JWTNotBeforeValidationSkew           int64                 `json:"jwt_not_before_validation_skew,omitempty"`
+	JWTSkipKid                           bool                  `json:"jwt_skip_kid,omitempty"`
+	JWTScopeToPolicyMapping              map[string]string     `json:"jwt_scope_to_policy_mapping,omitempty"`
+	JWTScopeClaimName                    string                `json:"jwt_scope_claim_name,omitempty"`
+	Scopes                               json.RawMessage       `json:"scopes,omitempty"`
+	IDPClientIDMappingDisabled           bool                  `json:"idp_client_id_mapping_disabled,omitempty"`
+	NotificationsDetails                 json.RawMessage       `json:"notifications,omitempty"`
+	EnableSignatureChecking              bool                  `json:"enable_signature_checking,omitempty"`
+	HmacAllowedClockSkew                 float64               `json:"hmac_allowed_clock_skew,omitempty"`
+	HmacAllowedAlgorithms                []string              `json:"hmac_allowed_algorithms,omitempty"`
+	RequestSigning                       json.RawMessage       `json:"request_signing,omitempty"`
+	BaseIdentityProvidedBy               string                `json:"base_identity_provided_by,omitempty"`
+	VersionDefinition                    json.RawMessage       `json:"definition,omitempty"`
+	VersionData                          *VersionData          `json:"version_data,omitempty"`
+	UptimeTests                          json.RawMessage       `json:"uptime_tests,omitempty"`
+	Proxy                                *ProxyConfig          `json:"proxy,omitempty"`
+	DisableRateLimit                     bool                  `json:"disable_rate_limit,omitempty"`
+	DisableQuota                         bool                  `json:"disable_quota,omitempty"`
+	CustomMiddleware                     json.RawMessage       `json:"custom_middleware,omitempty"`
+	CustomMiddlewareBundle               string                `json:"custom_middleware_bundle,omitempty"`
+	CustomMiddlewareBundleDisabled       bool                  `json:"custom_middleware_bundle_disabled,omitempty"`
+	CacheOptions                         json.RawMessage       `json:"cache_options,omitempty"`
+	SessionLifetime                      int64                 `json:"session_lifetime,omitempty"`
+	SessionLifetimeRespectsKeyExpiration bool                  `json:"session_lifetime_respects_key_expiration,omitempty"`
+	Active                               bool                  `json:"active,omitempty"`
+	Internal                             bool                  `json:"internal,omitempty"`
+	AuthProvider                         json.RawMessage       `json:"auth_provider,omitempty"`
+	SessionProvider                      json.RawMessage       `json:"session_provider,omitempty"`
+	EventHandlers                        json.RawMessage       `json:"event_handlers,omitempty"`
+	EnableBatchRequestSupport            bool                  `json:"enable_batch_request_support,omitempty"`
+	EnableIpWhiteListing                 bool                  `json:"enable_ip_whitelisting,omitempty"`
+	AllowedIPs                           []string              `json:"allowed_ips,omitempty"`
+	EnableIpBlacklisting                 bool                  `json:"enable_ip_blacklisting,omitempty"`
+	BlacklistedIPs                       []string              `json:"blacklisted_ips,omitempty"`
+	DontSetQuotasOnCreate                bool                  `json:"dont_set_quota_on_create,omitempty"`
+	ExpireAnalyticsAfter                 int64                 `json:"expire_analytics_after,omitempty"`
+	ResponseProcessors                   json.RawMessage       `json:"response_processors,omitempty"`
+	CORS                                 json.RawMessage       `json:"CORS,omitempty"`
+	Domain                               string                `json:"domain,omitempty"`
+	DomainDisabled                       bool                  `json:"domain_disabled,omitempty"`
+	Certificates                         []string              `json:"certificates,omitempty"`
+	DoNotTrack                           bool                  `json:"do_not_track,omitempty"`
+	EnableContextVars                    bool                  `json:"enable_context_vars,omitempty"`
+	ConfigData                           map[string]any        `json:"config_data,omitempty"`
+	ConfigDataDisabled                   bool                  `json:"config_data_disabled,omitempty"`
+	TagHeaders                           []string              `json:"tag_headers,omitempty"`
+	GlobalRateLimit                      json.RawMessage       `json:"global_rate_limit,omitempty"`
+	StripAuthData                        bool                  `json:"strip_auth_data,omitempty"`
+	EnableDetailedRecording              bool                  `json:"enable_detailed_recording,omitempty"`
+	GraphQL                              json.RawMessage       `json:"graphql,omitempty"`
+	AnalyticsPlugin                      json.RawMessage       `json:"analytics_plugin,omitempty"`
+	TagsDisabled                         bool                  `json:"tags_disabled,omitempty"`
+	Tags                                 []string              `json:"tags,omitempty"`
+	IsOAS                                bool                  `json:"is_oas,omitempty"`
+	DetailedTracing                      bool                  `json:"detailed_tracing,omitempty"`
+
+	// Unknown holds the fields of newer gateways.
+	Unknown UnknownFields `json:"-"`
+}
+
+func (d *APIDefinition) UnmarshalJSON(data []byte) error {
+	type apiDefinition APIDefinition
+	unknown, err := unmarshalWithUnknown(data, (*apiDefinition)(d))
+	d.Unknown = unknown
+	return err
+}
+
+func (d APIDefinition) MarshalJSON() ([]byte, error) {
+	type apiDefinition APIDefinition
+	return marshalWithUnknown(apiDefinition(d), d.Unknown)
+}
+
+type AuthConfig struct {
+	Name              string          `json:"name,omitempty"`
+	UseParam          bool            `json:"use_param,omitempty"`
+	ParamName         string          `json:"param_name,omitempty"`
+	UseCookie         bool            `json:"use_cookie,omitempty"`
+	CookieName        string          `json:"cookie_name,omitempty"`
+	DisableHeader     bool            `json:"disable_header,omitempty"`
+	AuthHeaderName    string          `json:"auth_header_name,omitempty"`
+	UseCertificate    bool            `json:"use_certificate,omitempty"`
+	ValidateSignature bool            `json:"validate_signature,omitempty"`
+	Signature         json.RawMessage `json:"signature,omitempty"`
+
+	// Unknown holds the fields of newer gateways.
+	Unknown UnknownFields `json:"-"`
+}
+
+func (a *AuthConfig) UnmarshalJSON(data []byte) error {
+	type authConfig AuthConfig
+	unknown, err := unmarshalWithUnknown(data, (*authConfig)(a))
+	a.Unknown = unknown
+	return err
+}
+
+func (a AuthConfig) MarshalJSON() ([]byte, error) {
+	type authConfig AuthConfig
+	return marshalWithUnknown(authConfig(a), a.Unknown)
+}
+
+type ProxyConfig struct {
+	PreserveHostHeader          bool            `json:"preserve_host_header,omitempty"`
+	ListenPath                  string          `json:"listen_path,omitempty"`
+	TargetURL                   string          `json:"target_url,omitempty"`
+	DisableStripSlash           bool            `json:"disable_strip_slash,omitempty"`
+	StripListenPath             bool            `json:"strip_listen_path,omitempty"`
+	EnableLoadBalancing         bool            `json:"enable_load_balancing,omitempty"`
+	Targets                     []string        `json:"target_list,omitempty"`
+	CheckHostAgainstUptimeTests bool            `json:"check_host_against_uptime_tests,omitempty"`
+	ServiceDiscovery            json.RawMessage `json:"service_discovery,omitempty"`
+	Transport                   json.RawMessage `json:"transport,omitempty"`
+
+	// Unknown holds the fields of newer gateways.
+	Unknown UnknownFields `json:"-"`
+}
+
+func (p *ProxyConfig) UnmarshalJSON(data []byte) error {
+	type proxyConfig ProxyConfig
+	unknown, err := unmarshalWithUnknown(data, (*proxyConfig)(p))
+	p.Unknown = unknown
+	return err
+}
+
+func (p ProxyConfig) MarshalJSON() ([]byte, error) {
+	type proxyConfig ProxyConfig
+	return marshalWithUnknown(proxyConfig(p), p.Unknown)
+}
+
+type VersionData struct {
+	NotVersioned   bool                   `json:"not_versioned,omitempty"`
+	DefaultVersion string                 `json:"default_version,omitempty"`
+	Versions       map[string]VersionInfo `json:"versions,omitempty"`
+
+	// Unknown holds the fields of newer gateways.
+	Unknown UnknownFields `json:"-"`
+}
+
+func (v *VersionData) UnmarshalJSON(data []byte) error {
+	type versionData VersionData
+	unknown, err := unmarshalWithUnknown(data, (*versionData)(v))
+	v.Unknown = unknown
+	return err
+}
+
+func (v VersionData) MarshalJSON() ([]byte, error) {
+	type versionData VersionData
+	return marshalWithUnknown(versionData(v), v.Unknown)
+}
+
+type VersionInfo struct {
+	Name                          string            `json:"name,omitempty"`
+	Expires                       string            `json:"expires,omitempty"`
+	Paths                         json.RawMessage   `json:"paths,omitempty"`
+	UseExtendedPaths              bool              `json:"use_extended_paths,omitempty"`
+	ExtendedPaths                 json.RawMessage   `json:"extended_paths,omitempty"`
+	GlobalHeaders                 map[string]string `json:"global_headers,omitempty"`
+	GlobalHeadersRemove           []string          `json:"global_headers_remove,omitempty"`
+	GlobalHeadersDisabled         bool              `json:"global_headers_disabled,omitempty"`
+	GlobalResponseHeaders         map[string]string `json:"global_response_headers,omitempty"`
+	GlobalResponseHeadersRemove   []string          `json:"global_response_headers_remove,omitempty"`
+	GlobalResponseHeadersDisabled bool              `json:"global_response_headers_disabled,omitempty"`
+	IgnoreEndpointCase            bool              `json:"ignore_endpoint_case,omitempty"`
+	GlobalSizeLimit               int64             `json:"global_size_limit,omitempty"`
+	OverrideTarget                string            `json:"override_target,omitempty"`
+
+	// Unknown holds the fields of newer gateways.
+	Unknown UnknownFields `json:"-"`
+}
+
+func (v *VersionInfo) UnmarshalJSON(data []byte) error {
+	type versionInfo VersionInfo
+	unknown, err := unmarshalWithUnknown(data, (*versionInfo)(v))
+	v.Unknown = unknown
+	return err
+}
+
+func (v VersionInfo) MarshalJSON() ([]byte, error) {
+	type versionInfo VersionInfo
+	return marshalWithUnknown(versionInfo(v), v.Unknown)
+}
+
+// Api converts the definition into the untyped form the API endpoints take.
+func (d APIDefinition) Api() (Api, error) {
+	return convertJSON[Api](d)
+}
+
+// APIDefinition converts the untyped API into its typed form.
+func (a Api) APIDefinition() (APIDefinition, error) {
+	return convertJSON[APIDefinition](a)
+}
+
+func (c *Client) CreateApi(api Api) (ApiModifyKeySuccess, error) {
+	return c.CreateApiContext(context.Background(), api)
+}
+
+func (c *Client) CreateApiContext(ctx context.Context, api Api) (ApiModifyKeySuccess, error) {
+	if c.isCluster() {
+		return fanOutCreate(c,
+			func(node *Client) (ApiModifyKeySuccess, error) { return node.CreateApiContext(ctx, api) },
+			func(node *Client, first ApiModifyKeySuccess) (ApiModifyKeySuccess, error) {
+				// Every node gets the API ID generated by the first one.
+				api := maps.Clone(api)
+				api["api_id"] = first.Key
+				return node.CreateApiContext(ctx, api)
+			},
+			func(node *Client, created ApiModifyKeySuccess) error { return node.DeleteApiContext(ctx, created.Key) },
+		)
+	}
+
+	var apiModifyKeySuccess ApiModifyKeySuccess
+
+	rb, err := json.Marshal(api)
+	if err != nil {
+		return apiModifyKeySuccess, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/tyk/apis", c.Host), strings.NewReader(string(rb)))
+	if err != nil {
+		return apiModifyKeySuccess, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return apiModifyKeySuccess, err
+	}
+
+	err = json.Unmarshal(body, &apiModifyKeySuccess)
+	if err != nil {
+		return ApiModifyKeySuccess{}, err
+	}
+
+	return apiModifyKeySuccess, nil
+}
+
+func (c *Client) GetApi(apiId string) (Api, error) {
+	return c.GetApiContext(context.Background(), apiId)
+}
+
+func (c *Client) GetApiContext(ctx context.Context, apiId string) (Api, error) {
+	if c.isCluster() {
+		return fanOutRead(c, func(node *Client) (Api, error) { return node.GetApiContext(ctx, apiId) })
+	}
+
+	var api Api
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/tyk/apis/%s", c.Host, apiId), nil)
+	if err != nil {
+		return api, err
+	}
+	body, err := c.doRequest(req)
+	if err != nil {
+		return api, err
+	}
+
+	err = json.Unmarshal(body, &api)
+	if err != nil {
+		return api, err
+	}
+	return api, nil
+}
+
+func (c *Client) UpdateApi(apiId string, api Api) (ApiModifyKeySuccess, error) {
+	return c.UpdateApiContext(context.Background(), apiId, api)
+}
+
+func (c *Client) UpdateApiContext(ctx context.Context, apiId string, api Api) (ApiModifyKeySuccess, error) {
+	if c.isCluster() {
+		return fanOutUpdate(c,
+			func(node *Client) (Api, error) { return node.GetApiContext(ctx, apiId) },
+			func(node *Client) (ApiModifyKeySuccess, error) { return node.UpdateApiContext(ctx, apiId, api) },
+			func(node *Client, previous Api) error {
+				_, err := node.UpdateApiContext(ctx, apiId, previous)
+				return err
+			},
+		)
+	}
+
+	var apiModifyKeySuccess ApiModifyKeySuccess
+
+	// The gateway rejects updates whose body api_id differs from the path.
+	api = maps.Clone(api)
+	api["api_id"] = apiId
+
+	rb, err := json.Marshal(api)
+	if err != nil {
+		return apiModifyKeySuccess, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "PUT", fmt.Sprintf("%s/tyk/apis/%s", c.Host, apiId), strings.NewReader(string(rb)))
+	if err != nil {
+		return apiModifyKeySuccess, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return apiModifyKeySuccess, err
+	}
+
+	err = json.Unmarshal(body, &apiModifyKeySuccess)
+	if err != nil {
+		return ApiModifyKeySuccess{}, err
+	}
+
+	return apiModifyKeySuccess, nil
+}
+
+func (c *Client) DeleteApi(apiId string) error {
+	return c.DeleteApiContext(context.Background(), apiId)
+}
+
+func (c *Client) DeleteApiContext(ctx context.Context, apiId string) error {
+	if c.isCluster() {
+		return fanOutDelete(c,
+			func(node *Client) (Api, error) { return node.GetApiContext(ctx, apiId) },
+			func(node *Client) error { return node.DeleteApiContext(ctx, apiId) },
+			func(node *Client, previous Api) error {
+				_, err := node.CreateApiContext(ctx, previous)
+				return err
+			},
+		)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "DELETE", fmt.Sprintf("%s/tyk/apis/%s", c.Host, apiId), nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.doRequest(req)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
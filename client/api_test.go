@@ -0,0 +1,55 @@
+package client
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// bodyRecorder is a gateway that records the body of the last request.
+func bodyRecorder(t *testing.T, body *map[string]any) *Client {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := json.NewDecoder(r.Body).Decode(body); err != nil {
+			t.Errorf("unexpected body: %v", err)
+		}
+		w.Write([]byte(`{"key":"api1","status":"ok","action":"modified"}`))
+	}))
+	t.Cleanup(server.Close)
+
+	c, _ := NewClient(server.URL, "secret")
+	return c
+}
+
+func TestUpdateApiSetsApiId(t *testing.T) {
+	var body map[string]any
+	c := bodyRecorder(t, &body)
+
+	api := Api{"name": "httpbin", "api_id": "other"}
+	if _, err := c.UpdateApi("api1", api); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if body["api_id"] != "api1" {
+		t.Errorf("expected the api_id of the path, got %v", body["api_id"])
+	}
+	if api["api_id"] != "other" {
+		t.Errorf("expected the caller's API to be left alone, got %v", api)
+	}
+}
+
+func TestUpdateOasApiSetsApiId(t *testing.T) {
+	var body map[string]any
+	c := bodyRecorder(t, &body)
+
+	api := OasApi{"openapi": "3.0.3", "x-tyk-api-gateway": map[string]any{"info": map[string]any{"id": "other"}}}
+	if _, err := c.UpdateOasApi("api1", api); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	info := body["x-tyk-api-gateway"].(map[string]any)["info"].(map[string]any)
+	if info["id"] != "api1" {
+		t.Errorf("expected the API ID of the path, got %v", info["id"])
+	}
+	if id := api["x-tyk-api-gateway"].(map[string]any)["info"].(map[string]any)["id"]; id != "other" {
+		t.Errorf("expected the caller's API to be left alone, got %v", id)
+	}
+}
@@ -0,0 +1,141 @@
+package client
+
+import (
+	"context"
+	"crypto/x509/pkix"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+type APICertificateStatusMessage struct {
+	CertID  string `json:"id"`
+	Status  string `json:"status"`
+	Message string `json:"message"`
+}
+
+// CertificateMeta is the CertsCertificateMeta schema of the gateway.
+type CertificateMeta struct {
+	ID            string    `json:"id"`
+	Fingerprint   string    `json:"fingerprint"`
+	HasPrivateKey bool      `json:"has_private"`
+	Issuer        pkix.Name `json:"issuer,omitempty"`
+	Subject       pkix.Name `json:"subject,omitempty"`
+	NotBefore     time.Time `json:"not_before,omitempty"`
+	NotAfter      time.Time `json:"not_after,omitempty"`
+	DNSNames      []string  `json:"dns_names,omitempty"`
+	IsCA          bool      `json:"is_ca"`
+
+	// Unknown holds the fields of newer gateways.
+	Unknown UnknownFields `json:"-"`
+}
+
+func (m *CertificateMeta) UnmarshalJSON(data []byte) error {
+	type certificateMeta CertificateMeta
+	unknown, err := unmarshalWithUnknown(data, (*certificateMeta)(m))
+	m.Unknown = unknown
+	return err
+}
+
+func (m CertificateMeta) MarshalJSON() ([]byte, error) {
+	type certificateMeta CertificateMeta
+	return marshalWithUnknown(certificateMeta(m), m.Unknown)
+}
+
+func (c *Client) CreateCertificate(certificate string, orgId string) (APICertificateStatusMessage, error) {
+	return c.CreateCertificateContext(context.Background(), certificate, orgId)
+}
+
+func (c *Client) CreateCertificateContext(ctx context.Context, certificate string, orgId string) (APICertificateStatusMessage, error) {
+	if c.isCluster() {
+		return fanOutCreate(c,
+			func(node *Client) (APICertificateStatusMessage, error) {
+				return node.CreateCertificateContext(ctx, certificate, orgId)
+			},
+			func(node *Client, first APICertificateStatusMessage) (APICertificateStatusMessage, error) {
+				return node.CreateCertificateContext(ctx, certificate, orgId)
+			},
+			func(node *Client, created APICertificateStatusMessage) error {
+				return node.DeleteCertificateContext(ctx, created.CertID, orgId)
+			},
+		)
+	}
+
+	var certificateStatus APICertificateStatusMessage
+
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/tyk/certs?org_id=%s", c.Host, url.QueryEscape(orgId)), strings.NewReader(certificate))
+	if err != nil {
+		return certificateStatus, err
+	}
+	req.Header.Set("Content-Type", "text/plain")
+
+	// Depending on its version, the gateway answers with 201 Created.
+	body, err := c.doRequest(req, http.StatusOK, http.StatusCreated)
+	if err != nil {
+		return certificateStatus, err
+	}
+
+	err = json.Unmarshal(body, &certificateStatus)
+	if err != nil {
+		return APICertificateStatusMessage{}, err
+	}
+
+	return certificateStatus, nil
+}
+
+func (c *Client) GetCertificate(certId string) (CertificateMeta, error) {
+	return c.GetCertificateContext(context.Background(), certId)
+}
+
+func (c *Client) GetCertificateContext(ctx context.Context, certId string) (CertificateMeta, error) {
+	if c.isCluster() {
+		return fanOutRead(c, func(node *Client) (CertificateMeta, error) { return node.GetCertificateContext(ctx, certId) })
+	}
+
+	var certificateMeta CertificateMeta
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/tyk/certs/%s", c.Host, certId), nil)
+	if err != nil {
+		return certificateMeta, err
+	}
+	body, err := c.doRequest(req)
+	if err != nil {
+		return certificateMeta, err
+	}
+
+	err = json.Unmarshal(body, &certificateMeta)
+	if err != nil {
+		return certificateMeta, err
+	}
+	return certificateMeta, nil
+}
+
+func (c *Client) DeleteCertificate(certId string, orgId string) error {
+	return c.DeleteCertificateContext(context.Background(), certId, orgId)
+}
+
+func (c *Client) DeleteCertificateContext(ctx context.Context, certId string, orgId string) error {
+	if c.isCluster() {
+		// The gateway never returns the certificate itself, so a deleted
+		// certificate cannot be restored.
+		return fanOutDelete[CertificateMeta](c,
+			func(node *Client) (CertificateMeta, error) { return node.GetCertificateContext(ctx, certId) },
+			func(node *Client) error { return node.DeleteCertificateContext(ctx, certId, orgId) },
+			nil,
+		)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "DELETE", fmt.Sprintf("%s/tyk/certs/%s?org_id=%s", c.Host, certId, url.QueryEscape(orgId)), nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.doRequest(req)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -1,16 +1,108 @@
 package client
 
 import (
+	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
+	"math/rand/v2"
 	"net/http"
+	"slices"
 	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 type Client struct {
 	Host       string
 	ApiKey     string
 	HTTPClient *http.Client
+
+	// Hosts are all gateways of a cluster, starting with Host. Writes go to
+	// every host when there is more than one.
+	Hosts []string
+
+	// MaxRetries is the number of times an idempotent request is retried
+	// after a transient failure.
+	MaxRetries int
+	// MinBackoff and MaxBackoff bound the exponential backoff between retries.
+	MinBackoff time.Duration
+	MaxBackoff time.Duration
+
+	// Reloader reloads the gateways after changes to APIs and policies. It
+	// is nil when hot reloads are off.
+	Reloader *Reloader
+}
+
+const (
+	DefaultMaxRetries = 3
+	DefaultMinBackoff = 1 * time.Second
+	DefaultMaxBackoff = 30 * time.Second
+)
+
+// transientStatusCodes are the statuses a gateway answers with while it is
+// restarting or overloaded.
+var transientStatusCodes = []int{
+	http.StatusTooManyRequests,
+	http.StatusBadGateway,
+	http.StatusServiceUnavailable,
+	http.StatusGatewayTimeout,
+}
+
+// idempotentMethods are the methods that are safe to send more than once.
+var idempotentMethods = []string{
+	http.MethodGet,
+	http.MethodHead,
+	http.MethodOptions,
+	http.MethodPut,
+	http.MethodDelete,
+}
+
+type ApiStatusMessage struct {
+	Status  string `json:"status"`
+	Message string `json:"message"`
+}
+
+// APIError is returned when the gateway answers with a status code the
+// endpoint does not document as a success.
+type APIError struct {
+	StatusCode int
+	Method     string
+	Path       string
+	ApiStatusMessage
+}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("%s %s: status: %d, message: %s", e.Method, e.Path, e.StatusCode, e.Message)
+}
+
+// IsNotFound reports whether err is, or wraps, an APIError for 404 Not Found.
+func IsNotFound(err error) bool {
+	return hasStatusCode(err, http.StatusNotFound)
+}
+
+// IsBadRequest reports whether err is, or wraps, an APIError for a request
+// the gateway rejected as invalid.
+func IsBadRequest(err error) bool {
+	return hasStatusCode(err, http.StatusBadRequest)
+}
+
+// IsUnauthorized reports whether err is, or wraps, an APIError for a request
+// the gateway refused to authorize.
+func IsUnauthorized(err error) bool {
+	return hasStatusCode(err, http.StatusUnauthorized) || hasStatusCode(err, http.StatusForbidden)
+}
+
+// IsServerError reports whether err is, or wraps, an APIError for a failure
+// on the gateway side.
+func IsServerError(err error) bool {
+	var apiError *APIError
+	return errors.As(err, &apiError) && apiError.StatusCode >= http.StatusInternalServerError
+}
+
+func hasStatusCode(err error, statusCode int) bool {
+	var apiError *APIError
+	return errors.As(err, &apiError) && apiError.StatusCode == statusCode
 }
 
 func NewClient(host, apiKey string) (*Client, error) {
@@ -18,26 +110,116 @@ func NewClient(host, apiKey string) (*Client, error) {
 		Host:       host,
 		ApiKey:     apiKey,
 		HTTPClient: &http.Client{Timeout: 10 * time.Second},
+		MaxRetries: DefaultMaxRetries,
+		MinBackoff: DefaultMinBackoff,
+		MaxBackoff: DefaultMaxBackoff,
 	}, nil
 }
 
-func (c *Client) doRequest(req *http.Request) ([]byte, error) {
+// doRequest sends req and returns the response body. Responses are successful
+// when their status code is one of successCodes, or 200 OK when none are given.
+// Idempotent requests are retried on transient failures.
+func (c *Client) doRequest(req *http.Request, successCodes ...int) ([]byte, error) {
 	req.Header.Set("X-Tyk-Authorization", c.ApiKey)
 
+	if len(successCodes) == 0 {
+		successCodes = []int{http.StatusOK}
+	}
+
+	for attempt := 0; ; attempt++ {
+		body, err := c.send(req, successCodes)
+		if err == nil || attempt >= c.MaxRetries || !isRetryable(req, err) {
+			return body, err
+		}
+
+		if req.GetBody != nil {
+			req.Body, err = req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		timer := time.NewTimer(c.backoff(attempt))
+		select {
+		case <-req.Context().Done():
+			timer.Stop()
+			return nil, req.Context().Err()
+		case <-timer.C:
+		}
+	}
+}
+
+func (c *Client) send(req *http.Request, successCodes []int) ([]byte, error) {
+	ctx := tflog.SetField(req.Context(), "method", req.Method)
+	ctx = tflog.SetField(ctx, "path", req.URL.Path)
+
+	tflog.Debug(ctx, "Sending Tyk Gateway request")
+
 	res, err := c.HTTPClient.Do(req)
 	if err != nil {
+		tflog.Debug(ctx, "Tyk Gateway request failed", map[string]interface{}{
+			"error": err.Error(),
+		})
 		return nil, err
 	}
 	defer res.Body.Close()
 
+	tflog.Debug(ctx, "Received Tyk Gateway response", map[string]interface{}{
+		"status_code": res.StatusCode,
+	})
+
 	body, err := io.ReadAll(res.Body)
 	if err != nil {
 		return nil, err
 	}
 
-	if res.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("status: %d, body: %s", res.StatusCode, body)
+	if slices.Contains(successCodes, res.StatusCode) {
+		return body, nil
+	}
+
+	apiError := &APIError{
+		StatusCode: res.StatusCode,
+		Method:     req.Method,
+		Path:       req.URL.Path,
+	}
+	if err := json.Unmarshal(body, &apiError.ApiStatusMessage); err != nil || apiError.Message == "" {
+		apiError.Message = string(body)
+	}
+	return nil, apiError
+}
+
+// isRetryable reports whether req may be sent again after failing with err.
+func isRetryable(req *http.Request, err error) bool {
+	if !slices.Contains(idempotentMethods, req.Method) {
+		return false
+	}
+
+	if req.Context().Err() != nil {
+		return false
+	}
+
+	var apiError *APIError
+	if errors.As(err, &apiError) {
+		return slices.Contains(transientStatusCodes, apiError.StatusCode)
+	}
+
+	// Anything else failed in transport, e.g. a connection reset while the
+	// gateway restarts.
+	return true
+}
+
+// backoff returns the delay before the given retry: exponential in attempt,
+// capped at MaxBackoff, with up to half of it randomized.
+func (c *Client) backoff(attempt int) time.Duration {
+	backoff := c.MinBackoff
+	for i := 0; i < attempt && backoff < c.MaxBackoff; i++ {
+		backoff *= 2
+	}
+	backoff = min(backoff, c.MaxBackoff)
+	if backoff <= 0 {
+		return 0
 	}
 
-	return body, err
+	half := backoff / 2
+	return half + rand.N(backoff-half+1)
 }
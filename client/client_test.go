@@ -0,0 +1,273 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestGetKeyNotFound(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"status":"error","message":"Key not found"}`))
+	}))
+	defer server.Close()
+
+	c, _ := NewClient(server.URL, "secret")
+
+	_, err := c.GetKey("missing")
+	if !IsNotFound(err) {
+		t.Fatalf("expected a not found error, got %v", err)
+	}
+
+	apiError := err.(*APIError)
+	if apiError.Message != "Key not found" {
+		t.Errorf("unexpected message %q", apiError.Message)
+	}
+	if apiError.Method != "GET" || apiError.Path != "/tyk/keys/missing" {
+		t.Errorf("unexpected request %s %s", apiError.Method, apiError.Path)
+	}
+}
+
+func TestGetKeyServerError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	c, _ := NewClient(server.URL, "secret")
+
+	_, err := c.GetKey("key")
+	if err == nil || IsNotFound(err) {
+		t.Fatalf("expected a non not found error, got %v", err)
+	}
+	if !IsServerError(err) {
+		t.Errorf("expected a server error, got %v", err)
+	}
+}
+
+func TestCreateKeyForbidden(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+		w.Write([]byte(`{"status":"error","message":"Attempted administrative access with invalid or missing key!"}`))
+	}))
+	defer server.Close()
+
+	c, _ := NewClient(server.URL, "wrong")
+
+	_, err := c.CreateKey(Key{})
+	if !IsUnauthorized(err) {
+		t.Fatalf("expected an unauthorized error, got %v", err)
+	}
+}
+
+func TestCreateKeyCreated(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"key":"abc","status":"ok","action":"added"}`))
+	}))
+	defer server.Close()
+
+	c, _ := NewClient(server.URL, "secret")
+
+	response, err := c.CreateKey(Key{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if response.Key != "abc" {
+		t.Errorf("unexpected key %q", response.Key)
+	}
+}
+
+func TestUpdateKeyCreatedIsError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusCreated)
+	}))
+	defer server.Close()
+
+	c, _ := NewClient(server.URL, "secret")
+
+	_, err := c.UpdateKey("key", Key{})
+	var apiError *APIError
+	if !errors.As(err, &apiError) || apiError.StatusCode != http.StatusCreated {
+		t.Fatalf("expected an APIError for 201, got %v", err)
+	}
+}
+
+func newRetryTestClient(url string) *Client {
+	c, _ := NewClient(url, "secret")
+	c.MaxRetries = 2
+	c.MinBackoff = time.Millisecond
+	c.MaxBackoff = 5 * time.Millisecond
+	return c
+}
+
+func TestRetryTransientStatus(t *testing.T) {
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if requests.Add(1) < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Write([]byte(`{"org_id":"default"}`))
+	}))
+	defer server.Close()
+
+	c := newRetryTestClient(server.URL)
+
+	key, err := c.GetKey("key")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if key["org_id"] != "default" {
+		t.Errorf("unexpected key %v", key)
+	}
+	if requests.Load() != 3 {
+		t.Errorf("expected 3 requests, got %d", requests.Load())
+	}
+}
+
+func TestRetryGivesUp(t *testing.T) {
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer server.Close()
+
+	c := newRetryTestClient(server.URL)
+
+	_, err := c.GetKey("key")
+	var apiError *APIError
+	if !errors.As(err, &apiError) || apiError.StatusCode != http.StatusBadGateway {
+		t.Fatalf("expected an APIError for 502, got %v", err)
+	}
+	if requests.Load() != 3 {
+		t.Errorf("expected 3 requests, got %d", requests.Load())
+	}
+}
+
+func TestRetryResendsBody(t *testing.T) {
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		if string(body) != `{"rate":10}` {
+			t.Errorf("unexpected body %q", body)
+		}
+		if requests.Add(1) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Write([]byte(`{"key":"key","status":"ok","action":"modified"}`))
+	}))
+	defer server.Close()
+
+	c := newRetryTestClient(server.URL)
+
+	_, err := c.UpdateKey("key", Key{"rate": 10})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if requests.Load() != 2 {
+		t.Errorf("expected 2 requests, got %d", requests.Load())
+	}
+}
+
+func TestRetryConnectionReset(t *testing.T) {
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if requests.Add(1) == 1 {
+			conn, _, _ := w.(http.Hijacker).Hijack()
+			conn.Close()
+			return
+		}
+		w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	c := newRetryTestClient(server.URL)
+
+	err := c.DeleteKey("key")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if requests.Load() != 2 {
+		t.Errorf("expected 2 requests, got %d", requests.Load())
+	}
+}
+
+func TestNoRetryNonIdempotent(t *testing.T) {
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	c := newRetryTestClient(server.URL)
+
+	_, err := c.CreateKey(Key{})
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+	if requests.Load() != 1 {
+		t.Errorf("expected 1 request, got %d", requests.Load())
+	}
+}
+
+func TestNoRetryPermanentStatus(t *testing.T) {
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		w.WriteHeader(http.StatusBadRequest)
+	}))
+	defer server.Close()
+
+	c := newRetryTestClient(server.URL)
+
+	_, err := c.GetKey("key")
+	if !IsBadRequest(err) {
+		t.Fatalf("expected a bad request error, got %v", err)
+	}
+	if requests.Load() != 1 {
+		t.Errorf("expected 1 request, got %d", requests.Load())
+	}
+}
+
+func TestBackoff(t *testing.T) {
+	c := &Client{MinBackoff: time.Second, MaxBackoff: 10 * time.Second}
+
+	for attempt, expected := range []time.Duration{time.Second, 2 * time.Second, 4 * time.Second, 8 * time.Second, 10 * time.Second, 10 * time.Second} {
+		backoff := c.backoff(attempt)
+		if backoff < expected/2 || backoff > expected {
+			t.Errorf("attempt %d: backoff %s outside [%s, %s]", attempt, backoff, expected/2, expected)
+		}
+	}
+}
+
+func TestGetKeyContextCanceled(t *testing.T) {
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		<-r.Context().Done()
+	}))
+	defer server.Close()
+
+	c := newRetryTestClient(server.URL)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	_, err := c.GetKeyContext(ctx, "key")
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected a deadline exceeded error, got %v", err)
+	}
+	if requests.Load() != 1 {
+		t.Errorf("expected 1 request, got %d", requests.Load())
+	}
+}
@@ -0,0 +1,283 @@
+package client
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// volatileFields are fields every gateway node maintains on its own. They are
+// ignored when comparing the nodes of a cluster.
+var volatileFields = map[string]bool{
+	"quota_remaining":       true,
+	"quota_renews":          true,
+	"date_created":          true,
+	"last_updated":          true,
+	"last_check":            true,
+	"id_extractor_deadline": true,
+}
+
+// NewClusterClient creates a client for a cluster of gateways that are not
+// backed by a dashboard. Writes go to every node, reads compare all nodes.
+func NewClusterClient(hosts []string, apiKey string) (*Client, error) {
+	if len(hosts) == 0 {
+		return nil, errors.New("at least one gateway host is required")
+	}
+
+	c, err := NewClient(hosts[0], apiKey)
+	if err != nil {
+		return nil, err
+	}
+	c.Hosts = hosts
+	return c, nil
+}
+
+func (c *Client) isCluster() bool {
+	return len(c.Hosts) > 1
+}
+
+// nodes returns a single node client for each host of the cluster.
+func (c *Client) nodes() []*Client {
+	nodes := make([]*Client, 0, len(c.Hosts))
+	for _, host := range c.Hosts {
+		node := *c
+		node.Host = host
+		node.Hosts = nil
+		nodes = append(nodes, &node)
+	}
+	return nodes
+}
+
+// nodeError attributes err to the node it happened on.
+func nodeError(node *Client, err error) error {
+	return fmt.Errorf("gateway %s: %w", node.Host, err)
+}
+
+// fanOutCreate creates an object on the first node with createFirst, then on
+// the other nodes with createRest, which receives the first result so that
+// generated IDs and secrets are the same on every node. When a node fails,
+// the object is removed again from the nodes already written.
+func fanOutCreate[T any](c *Client, createFirst func(node *Client) (T, error), createRest func(node *Client, first T) (T, error), undo func(node *Client, created T) error) (T, error) {
+	var first T
+	var written []*Client
+	var created []T
+
+	for i, node := range c.nodes() {
+		var result T
+		var err error
+		if i == 0 {
+			result, err = createFirst(node)
+			first = result
+		} else {
+			result, err = createRest(node, first)
+		}
+
+		if err != nil {
+			err = nodeError(node, err)
+			for j := len(written) - 1; j >= 0; j-- {
+				if undoErr := undo(written[j], created[j]); undoErr != nil {
+					err = errors.Join(err, fmt.Errorf("rolling back: %w", nodeError(written[j], undoErr)))
+				}
+			}
+			var zero T
+			return zero, err
+		}
+
+		written = append(written, node)
+		created = append(created, result)
+	}
+
+	return first, nil
+}
+
+// fanOutUpdate updates an object on every node. The object is read first, so
+// that the nodes already written can be restored when a node fails.
+func fanOutUpdate[S any, T any](c *Client, get func(node *Client) (S, error), update func(node *Client) (T, error), restore func(node *Client, previous S) error) (T, error) {
+	var first T
+	var written []*Client
+	var previous []S
+
+	for i, node := range c.nodes() {
+		snapshot, err := get(node)
+		if err == nil {
+			var result T
+			result, err = update(node)
+			if i == 0 {
+				first = result
+			}
+		}
+
+		if err != nil {
+			err = nodeError(node, err)
+			for j := len(written) - 1; j >= 0; j-- {
+				if restoreErr := restore(written[j], previous[j]); restoreErr != nil {
+					err = errors.Join(err, fmt.Errorf("rolling back: %w", nodeError(written[j], restoreErr)))
+				}
+			}
+			var zero T
+			return zero, err
+		}
+
+		written = append(written, node)
+		previous = append(previous, snapshot)
+	}
+
+	return first, nil
+}
+
+// fanOutDelete deletes an object on every node. Nodes that do not have the
+// object are skipped. The object is read first, so that it can be recreated
+// on the nodes already written when a node fails. A nil restore means the
+// object cannot be recreated from what the gateway returns.
+func fanOutDelete[S any](c *Client, get func(node *Client) (S, error), del func(node *Client) error, restore func(node *Client, previous S) error) error {
+	var written []*Client
+	var previous []S
+
+	for _, node := range c.nodes() {
+		snapshot, err := get(node)
+		if IsNotFound(err) {
+			continue
+		}
+		if err == nil {
+			err = del(node)
+		}
+
+		if err != nil {
+			err = nodeError(node, err)
+			for j := len(written) - 1; j >= 0; j-- {
+				if restore == nil {
+					err = errors.Join(err, fmt.Errorf("rolling back: gateway %s: deleted object cannot be restored", written[j].Host))
+					continue
+				}
+				if restoreErr := restore(written[j], previous[j]); restoreErr != nil {
+					err = errors.Join(err, fmt.Errorf("rolling back: %w", nodeError(written[j], restoreErr)))
+				}
+			}
+			return err
+		}
+
+		written = append(written, node)
+		previous = append(previous, snapshot)
+	}
+
+	return nil
+}
+
+// ErrMissingOnSomeNodes is returned when an object is read from a cluster and
+// only some of the nodes have it.
+var ErrMissingOnSomeNodes = errors.New("object is missing on some gateway nodes")
+
+// fanOutRead reads an object from every node. When the nodes diverge, the
+// result of the first node that differs from the first node is returned, so
+// that the divergence shows up as drift. The not found error is only returned
+// when no node has the object; when some nodes lack it, ErrMissingOnSomeNodes
+// is returned instead, so that the object is not forgotten.
+func fanOutRead[T any](c *Client, get func(node *Client) (T, error)) (T, error) {
+	var first, diverging T
+	var firstDocument any
+	var zero T
+	var found, diverged bool
+	var missing []string
+	var notFound error
+
+	for _, node := range c.nodes() {
+		result, err := get(node)
+		if IsNotFound(err) {
+			missing = append(missing, node.Host)
+			if notFound == nil {
+				notFound = nodeError(node, err)
+			}
+			continue
+		}
+		if err != nil {
+			return zero, nodeError(node, err)
+		}
+
+		document, err := comparableDocument(result)
+		if err != nil {
+			return zero, err
+		}
+
+		if !found {
+			first = result
+			firstDocument = document
+			found = true
+			continue
+		}
+
+		if !diverged && !reflect.DeepEqual(firstDocument, document) {
+			diverging = result
+			diverged = true
+		}
+	}
+
+	if !found {
+		return zero, notFound
+	}
+	if len(missing) > 0 {
+		return zero, fmt.Errorf("%w: %s", ErrMissingOnSomeNodes, strings.Join(missing, ", "))
+	}
+	if diverged {
+		return diverging, nil
+	}
+	return first, nil
+}
+
+// fanOutAll sends a request that changes nothing to be rolled back to every
+// node, and returns the result of the first node.
+func fanOutAll[T any](c *Client, send func(node *Client) (T, error)) (T, error) {
+	var first T
+
+	for i, node := range c.nodes() {
+		result, err := send(node)
+		if err != nil {
+			var zero T
+			return zero, nodeError(node, err)
+		}
+		if i == 0 {
+			first = result
+		}
+	}
+
+	return first, nil
+}
+
+// comparableDocument decodes value into generic JSON without volatile fields.
+func comparableDocument(value any) (any, error) {
+	rb, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+
+	var document any
+	err = json.Unmarshal(rb, &document)
+	if err != nil {
+		return nil, err
+	}
+
+	return stripVolatileFields(document), nil
+}
+
+func stripVolatileFields(value any) any {
+	switch value := value.(type) {
+	case map[string]any:
+		stripped := make(map[string]any, len(value))
+		for field, fieldValue := range value {
+			if volatileFields[field] {
+				continue
+			}
+			stripped[field] = stripVolatileFields(fieldValue)
+		}
+		return stripped
+	case []any:
+		stripped := make([]any, 0, len(value))
+		for _, item := range value {
+			stripped = append(stripped, stripVolatileFields(item))
+		}
+		return stripped
+	default:
+		return value
+	}
+}
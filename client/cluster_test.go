@@ -0,0 +1,206 @@
+package client
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// policyNode is a gateway node that only stores policies.
+type policyNode struct {
+	*httptest.Server
+
+	mu       sync.Mutex
+	policies map[string]Policy
+	failing  string
+}
+
+func newPolicyNode(t *testing.T) *policyNode {
+	node := &policyNode{policies: map[string]Policy{}}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /tyk/policies", func(w http.ResponseWriter, r *http.Request) {
+		var policy Policy
+		json.NewDecoder(r.Body).Decode(&policy)
+		if policy.ID == "" {
+			policy.ID = "generated-" + policy.Name
+		}
+		node.policies[policy.ID] = policy
+		json.NewEncoder(w).Encode(ApiModifyKeySuccess{Key: policy.ID, Status: "ok", Action: "added"})
+	})
+	mux.HandleFunc("GET /tyk/policies/{polID}", func(w http.ResponseWriter, r *http.Request) {
+		policy, ok := node.policies[r.PathValue("polID")]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		json.NewEncoder(w).Encode(policy)
+	})
+	mux.HandleFunc("PUT /tyk/policies/{polID}", func(w http.ResponseWriter, r *http.Request) {
+		var policy Policy
+		json.NewDecoder(r.Body).Decode(&policy)
+		node.policies[r.PathValue("polID")] = policy
+		json.NewEncoder(w).Encode(ApiModifyKeySuccess{Key: policy.ID, Status: "ok", Action: "modified"})
+	})
+	mux.HandleFunc("DELETE /tyk/policies/{polID}", func(w http.ResponseWriter, r *http.Request) {
+		delete(node.policies, r.PathValue("polID"))
+		json.NewEncoder(w).Encode(ApiModifyKeySuccess{Key: r.PathValue("polID"), Status: "ok", Action: "deleted"})
+	})
+
+	node.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		node.mu.Lock()
+		defer node.mu.Unlock()
+
+		if node.failing == r.Method {
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(`{"status":"error","message":"node failure"}`))
+			return
+		}
+		mux.ServeHTTP(w, r)
+	}))
+	t.Cleanup(node.Close)
+
+	return node
+}
+
+func newClusterTestClient(t *testing.T, nodes ...*policyNode) *Client {
+	var hosts []string
+	for _, node := range nodes {
+		hosts = append(hosts, node.URL)
+	}
+
+	c, err := NewClusterClient(hosts, "secret")
+	if err != nil {
+		t.Fatal(err)
+	}
+	c.MaxRetries = 0
+	return c
+}
+
+func TestClusterCreatePolicy(t *testing.T) {
+	first, second := newPolicyNode(t), newPolicyNode(t)
+	c := newClusterTestClient(t, first, second)
+
+	response, err := c.CreatePolicy(Policy{Name: "gold", Rate: 10})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	for _, node := range []*policyNode{first, second} {
+		policy, ok := node.policies[response.Key]
+		if !ok || policy.Rate != 10 {
+			t.Errorf("node %s: expected policy %s, got %v", node.URL, response.Key, node.policies)
+		}
+	}
+}
+
+func TestClusterCreatePolicyRollback(t *testing.T) {
+	first, second := newPolicyNode(t), newPolicyNode(t)
+	second.failing = http.MethodPost
+	c := newClusterTestClient(t, first, second)
+
+	_, err := c.CreatePolicy(Policy{Name: "gold"})
+	if !IsServerError(err) || !strings.Contains(err.Error(), second.URL) {
+		t.Fatalf("expected a server error from %s, got %v", second.URL, err)
+	}
+
+	if len(first.policies) != 0 {
+		t.Errorf("expected the policy to be rolled back, got %v", first.policies)
+	}
+}
+
+func TestClusterUpdatePolicyRollback(t *testing.T) {
+	first, second := newPolicyNode(t), newPolicyNode(t)
+	c := newClusterTestClient(t, first, second)
+
+	response, err := c.CreatePolicy(Policy{Name: "gold", Rate: 10})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	second.failing = http.MethodPut
+	_, err = c.UpdatePolicy(response.Key, Policy{ID: response.Key, Name: "gold", Rate: 20})
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+
+	if rate := first.policies[response.Key].Rate; rate != 10 {
+		t.Errorf("expected the update to be rolled back, got rate %v", rate)
+	}
+}
+
+func TestClusterDeletePolicyRollback(t *testing.T) {
+	first, second := newPolicyNode(t), newPolicyNode(t)
+	c := newClusterTestClient(t, first, second)
+
+	response, err := c.CreatePolicy(Policy{Name: "gold", Rate: 10})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	second.failing = http.MethodDelete
+	err = c.DeletePolicy(response.Key)
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+
+	if _, ok := first.policies[response.Key]; !ok {
+		t.Errorf("expected the policy to be restored, got %v", first.policies)
+	}
+}
+
+func TestClusterDeletePolicyMissingOnNode(t *testing.T) {
+	first, second := newPolicyNode(t), newPolicyNode(t)
+	first.policies["gold"] = Policy{ID: "gold"}
+	c := newClusterTestClient(t, first, second)
+
+	err := c.DeletePolicy("gold")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(first.policies) != 0 {
+		t.Errorf("expected the policy to be deleted, got %v", first.policies)
+	}
+}
+
+func TestClusterGetPolicyDivergence(t *testing.T) {
+	first, second := newPolicyNode(t), newPolicyNode(t)
+	first.policies["gold"] = Policy{ID: "gold", Rate: 10, LastUpdated: "1"}
+	second.policies["gold"] = Policy{ID: "gold", Rate: 10, LastUpdated: "2"}
+	c := newClusterTestClient(t, first, second)
+
+	// Nodes that only differ in fields they maintain on their own agree.
+	policy, err := c.GetPolicy("gold")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if policy.LastUpdated != "1" {
+		t.Errorf("expected the first node's policy, got %v", policy)
+	}
+
+	second.policies["gold"] = Policy{ID: "gold", Rate: 20}
+	policy, err = c.GetPolicy("gold")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if policy.Rate != 20 {
+		t.Errorf("expected the diverging policy, got %v", policy)
+	}
+
+	// An object missing on some nodes must not read as deleted.
+	delete(second.policies, "gold")
+	_, err = c.GetPolicy("gold")
+	if !errors.Is(err, ErrMissingOnSomeNodes) || IsNotFound(err) {
+		t.Errorf("expected a missing on some nodes error, got %v", err)
+	}
+
+	delete(first.policies, "gold")
+	_, err = c.GetPolicy("gold")
+	if !IsNotFound(err) {
+		t.Errorf("expected a not found error, got %v", err)
+	}
+}
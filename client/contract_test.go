@@ -0,0 +1,779 @@
+package client
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"maps"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"regexp"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/santhosh-tekuri/jsonschema/v5"
+	"gopkg.in/yaml.v3"
+)
+
+// contractSpecFile is the gateway OpenAPI document the client is checked
+// against.
+const contractSpecFile = "../gateway-swagger.yml"
+
+// contractSpecUrl is the URL the spec is known by to the schema compiler.
+const contractSpecUrl = "https://tyk.io/gateway-swagger.json"
+
+// contractSpec is a loaded gateway OpenAPI document.
+type contractSpec struct {
+	document map[string]any
+	paths    []contractPath
+
+	mu       sync.Mutex
+	compiler *jsonschema.Compiler
+	schemas  map[string]*jsonschema.Schema
+}
+
+type contractPath struct {
+	template string
+	pattern  *regexp.Regexp
+	params   []string
+	item     map[string]any
+}
+
+// contractOperation is the operation a request was matched to.
+type contractOperation struct {
+	method    string
+	path      contractPath
+	pointer   string
+	operation map[string]any
+}
+
+var (
+	loadContractSpecOnce sync.Once
+	loadedContractSpec   *contractSpec
+	loadContractSpecErr  error
+)
+
+func loadContractSpec(t *testing.T) *contractSpec {
+	t.Helper()
+
+	loadContractSpecOnce.Do(func() {
+		loadedContractSpec, loadContractSpecErr = newContractSpec(contractSpecFile)
+	})
+	if loadContractSpecErr != nil {
+		t.Fatalf("loading %s: %v", contractSpecFile, loadContractSpecErr)
+	}
+	return loadedContractSpec
+}
+
+func newContractSpec(file string) (*contractSpec, error) {
+	raw, err := os.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+
+	var document map[string]any
+	if err := yaml.Unmarshal(raw, &document); err != nil {
+		return nil, err
+	}
+
+	adaptOpenAPISchemas(document)
+
+	rb, err := json.Marshal(document)
+	if err != nil {
+		return nil, err
+	}
+
+	compiler := jsonschema.NewCompiler()
+	// OpenAPI 3.0 schemas are a dialect of JSON Schema draft 4.
+	compiler.Draft = jsonschema.Draft4
+	if err := compiler.AddResource(contractSpecUrl, bytes.NewReader(rb)); err != nil {
+		return nil, err
+	}
+	// The OAS API schemas reference the OpenAPI 3.0 meta schema, which is not
+	// part of the spec. Any OpenAPI document is accepted in its place.
+	if err := compiler.AddResource("https://raw.githubusercontent.com/TykTechnologies/tyk/refs/heads/master/apidef/oas/schema/3.0.json", strings.NewReader(`{}`)); err != nil {
+		return nil, err
+	}
+
+	spec := &contractSpec{
+		document: document,
+		compiler: compiler,
+		schemas:  map[string]*jsonschema.Schema{},
+	}
+
+	paths, _ := document["paths"].(map[string]any)
+	for template, item := range paths {
+		item, _ := item.(map[string]any)
+		path := contractPath{template: template, item: item}
+
+		pattern := regexp.QuoteMeta(template)
+		for _, match := range regexp.MustCompile(`\\\{(\w+)\\\}`).FindAllStringSubmatch(pattern, -1) {
+			path.params = append(path.params, match[1])
+			pattern = strings.Replace(pattern, match[0], `([^/]+)`, 1)
+		}
+		path.pattern = regexp.MustCompile("^" + pattern + "$")
+
+		spec.paths = append(spec.paths, path)
+	}
+
+	// Literal paths such as /tyk/keys/create win over /tyk/keys/{keyID}.
+	sort.Slice(spec.paths, func(i, j int) bool {
+		if len(spec.paths[i].params) != len(spec.paths[j].params) {
+			return len(spec.paths[i].params) < len(spec.paths[j].params)
+		}
+		return spec.paths[i].template < spec.paths[j].template
+	})
+
+	return spec, nil
+}
+
+// adaptOpenAPISchemas rewrites the OpenAPI schema extensions into plain JSON
+// Schema, and closes object schemas so that undocumented fields are reported.
+func adaptOpenAPISchemas(document map[string]any) {
+	// Schemas that are combined with allOf cannot be closed, as each of them
+	// only lists its own properties.
+	combined := map[string]bool{}
+	walkJSON(document, func(object map[string]any) {
+		allOf, _ := object["allOf"].([]any)
+		for i, part := range allOf {
+			part, _ := part.(map[string]any)
+			// The spec applies the extension schema to the whole OAS document
+			// instead of its x-tyk-api-gateway field.
+			if part["$ref"] == "#/components/schemas/XTykAPIGateway" {
+				allOf[i] = map[string]any{
+					"properties": map[string]any{"x-tyk-api-gateway": part},
+					"x-combined": true,
+				}
+				continue
+			}
+			if ref, ok := part["$ref"].(string); ok {
+				combined[ref] = true
+			} else if part != nil {
+				part["x-combined"] = true
+			}
+		}
+	})
+
+	components, _ := document["components"].(map[string]any)
+	schemas, _ := components["schemas"].(map[string]any)
+	for name, schema := range schemas {
+		schema, _ := schema.(map[string]any)
+		if schema != nil && combined["#/components/schemas/"+name] {
+			schema["x-combined"] = true
+		}
+	}
+
+	walkJSON(document, func(object map[string]any) {
+		if _, ok := object["properties"].(map[string]any); ok {
+			if _, ok := object["additionalProperties"]; !ok && object["x-combined"] != true {
+				object["additionalProperties"] = false
+			}
+		}
+
+		if object["nullable"] != true {
+			return
+		}
+		delete(object, "nullable")
+		switch schemaType := object["type"].(type) {
+		case string:
+			object["type"] = []any{schemaType, "null"}
+		case nil:
+			// $ref ignores its siblings in draft 4.
+			nullable := map[string]any{}
+			for key, value := range object {
+				nullable[key] = value
+				delete(object, key)
+			}
+			object["anyOf"] = []any{nullable, map[string]any{"type": "null"}}
+		}
+		if enum, ok := object["enum"].([]any); ok {
+			object["enum"] = append(enum, nil)
+		}
+	})
+}
+
+// walkJSON calls visit for every object of a decoded JSON document, parents
+// before their children.
+func walkJSON(value any, visit func(object map[string]any)) {
+	switch value := value.(type) {
+	case map[string]any:
+		visit(value)
+		for _, child := range value {
+			walkJSON(child, visit)
+		}
+	case []any:
+		for _, child := range value {
+			walkJSON(child, visit)
+		}
+	}
+}
+
+// jsonPointer escapes the tokens of a JSON pointer into the spec.
+func jsonPointer(tokens ...string) string {
+	var pointer strings.Builder
+	for _, token := range tokens {
+		token = strings.ReplaceAll(token, "~", "~0")
+		token = strings.ReplaceAll(token, "/", "~1")
+		pointer.WriteString("/" + url.PathEscape(token))
+	}
+	return pointer.String()
+}
+
+// schema compiles the schema at pointer.
+func (s *contractSpec) schema(pointer string) (*jsonschema.Schema, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if schema, ok := s.schemas[pointer]; ok {
+		return schema, nil
+	}
+	schema, err := s.compiler.Compile(contractSpecUrl + "#" + pointer)
+	if err != nil {
+		return nil, err
+	}
+	s.schemas[pointer] = schema
+	return schema, nil
+}
+
+// resolve follows a local $ref of object.
+func (s *contractSpec) resolve(object map[string]any) map[string]any {
+	ref, ok := object["$ref"].(string)
+	if !ok || !strings.HasPrefix(ref, "#/") {
+		return object
+	}
+
+	var current any = s.document
+	for _, token := range strings.Split(strings.TrimPrefix(ref, "#/"), "/") {
+		token = strings.ReplaceAll(strings.ReplaceAll(token, "~1", "/"), "~0", "~")
+		current, _ = current.(map[string]any)[token]
+	}
+	resolved, _ := current.(map[string]any)
+	return resolved
+}
+
+// match finds the operation for a request.
+func (s *contractSpec) match(method string, path string) (contractOperation, []string, bool) {
+	for _, candidate := range s.paths {
+		values := candidate.pattern.FindStringSubmatch(path)
+		if values == nil {
+			continue
+		}
+
+		operation, ok := candidate.item[strings.ToLower(method)].(map[string]any)
+		if !ok {
+			continue
+		}
+		return contractOperation{
+			method:    strings.ToLower(method),
+			path:      candidate,
+			pointer:   jsonPointer("paths", candidate.template, strings.ToLower(method)),
+			operation: operation,
+		}, values[1:], true
+	}
+	return contractOperation{}, nil, false
+}
+
+// parameters returns the parameters of an operation, including those of its
+// path, by location and name.
+func (s *contractSpec) parameters(operation contractOperation) map[string]map[string]any {
+	parameters := map[string]map[string]any{}
+	for _, source := range []map[string]any{operation.path.item, operation.operation} {
+		list, _ := source["parameters"].([]any)
+		for _, parameter := range list {
+			parameter := s.resolve(parameter.(map[string]any))
+			in, _ := parameter["in"].(string)
+			name, _ := parameter["name"].(string)
+			parameters[in+":"+name] = parameter
+		}
+	}
+	return parameters
+}
+
+// validateRequest reports every way a request does not match the spec.
+func (s *contractSpec) validateRequest(r *http.Request, body []byte) (contractOperation, []string) {
+	operation, pathValues, ok := s.match(r.Method, r.URL.Path)
+	if !ok {
+		return operation, []string{fmt.Sprintf("%s %s is not in the spec", r.Method, r.URL.Path)}
+	}
+
+	var violations []string
+	parameters := s.parameters(operation)
+
+	for i, name := range operation.path.params {
+		if pathValues[i] == "" {
+			violations = append(violations, fmt.Sprintf("path parameter %s is empty", name))
+		}
+	}
+
+	query := r.URL.Query()
+	for name, values := range query {
+		parameter, ok := parameters["query:"+name]
+		if !ok {
+			violations = append(violations, fmt.Sprintf("query parameter %s is not in the spec", name))
+			continue
+		}
+		for _, value := range values {
+			if err := s.validateParameter(parameter, value); err != nil {
+				violations = append(violations, fmt.Sprintf("query parameter %s=%s: %v", name, value, err))
+			}
+		}
+	}
+	for key, parameter := range parameters {
+		name, _ := parameter["name"].(string)
+		if strings.HasPrefix(key, "query:") && parameter["required"] == true && !query.Has(name) {
+			violations = append(violations, fmt.Sprintf("required query parameter %s is missing", name))
+		}
+	}
+
+	requestBody, _ := operation.operation["requestBody"].(map[string]any)
+	if requestBody == nil {
+		if len(body) > 0 {
+			violations = append(violations, "the operation takes no request body")
+		}
+		return operation, violations
+	}
+
+	content, _ := requestBody["content"].(map[string]any)
+	switch {
+	case content["application/json"] != nil:
+		var document any
+		decoder := json.NewDecoder(bytes.NewReader(body))
+		decoder.UseNumber()
+		if err := decoder.Decode(&document); err != nil {
+			violations = append(violations, fmt.Sprintf("request body is not JSON: %v", err))
+			break
+		}
+		err := s.validate(jsonPointer("paths", operation.path.template, operation.method, "requestBody", "content", "application/json", "schema"), document)
+		if err != nil {
+			violations = append(violations, fmt.Sprintf("request body: %v", err))
+		}
+	case content["text/plain"] != nil:
+		if len(body) == 0 {
+			violations = append(violations, "request body is empty")
+		}
+	}
+
+	return operation, violations
+}
+
+// validateParameter validates the string value of a parameter against the
+// type of its schema.
+func (s *contractSpec) validateParameter(parameter map[string]any, value string) error {
+	schema, _ := parameter["schema"].(map[string]any)
+	schema = s.resolve(schema)
+
+	var typed any = value
+	var err error
+	switch schema["type"] {
+	case "boolean":
+		typed, err = strconv.ParseBool(value)
+		if value != "true" && value != "false" {
+			err = fmt.Errorf("expected true or false")
+		}
+	case "integer":
+		typed, err = strconv.ParseInt(value, 10, 64)
+	case "number":
+		typed, err = strconv.ParseFloat(value, 64)
+	}
+	if err != nil {
+		return err
+	}
+
+	if enum, ok := schema["enum"].([]any); ok && !slices.ContainsFunc(enum, func(allowed any) bool {
+		return fmt.Sprint(allowed) == fmt.Sprint(typed)
+	}) {
+		return fmt.Errorf("expected one of %v", enum)
+	}
+	return nil
+}
+
+// validate validates a decoded JSON document against the schema at pointer.
+// The gateway decodes null as the zero value, so null fields are accepted.
+func (s *contractSpec) validate(pointer string, document any) error {
+	schema, err := s.schema(pointer)
+	if err != nil {
+		return err
+	}
+	return schema.Validate(withoutNulls(document))
+}
+
+func withoutNulls(value any) any {
+	switch value := value.(type) {
+	case map[string]any:
+		stripped := map[string]any{}
+		for key, child := range value {
+			if child != nil {
+				stripped[key] = withoutNulls(child)
+			}
+		}
+		return stripped
+	case []any:
+		stripped := make([]any, 0, len(value))
+		for _, child := range value {
+			stripped = append(stripped, withoutNulls(child))
+		}
+		return stripped
+	default:
+		return value
+	}
+}
+
+// successResponse returns the first documented 2xx status of an operation
+// and the pointer to its JSON schema.
+func (s *contractSpec) successResponse(operation contractOperation) (int, string, bool) {
+	responses, _ := operation.operation["responses"].(map[string]any)
+
+	var codes []string
+	for code := range responses {
+		if strings.HasPrefix(code, "2") {
+			codes = append(codes, code)
+		}
+	}
+	if len(codes) == 0 {
+		return 0, "", false
+	}
+	sort.Strings(codes)
+
+	status, _ := strconv.Atoi(codes[0])
+	return status, jsonPointer("paths", operation.path.template, operation.method, "responses", codes[0], "content", "application/json", "schema"), true
+}
+
+// responseBody builds a response from the documented example, or from the
+// schema when there is no example that matches it.
+func (s *contractSpec) responseBody(operation contractOperation, status int, pointer string) any {
+	response := s.resolve(operation.operation["responses"].(map[string]any)[strconv.Itoa(status)].(map[string]any))
+	content, _ := response["content"].(map[string]any)
+	media, _ := content["application/json"].(map[string]any)
+
+	var candidates []any
+	if example, ok := media["example"]; ok {
+		candidates = append(candidates, example)
+	}
+	// Examples are tried in a stable order, as some operations document
+	// different forms of the response, such as a single certificate or a list.
+	examples, _ := media["examples"].(map[string]any)
+	for _, name := range slices.Sorted(maps.Keys(examples)) {
+		if value, ok := s.resolve(examples[name].(map[string]any))["value"]; ok {
+			candidates = append(candidates, value)
+		}
+	}
+
+	for _, candidate := range candidates {
+		if s.validate(pointer, normalizeJSON(candidate)) == nil {
+			return candidate
+		}
+	}
+
+	schema, _ := media["schema"].(map[string]any)
+	return s.synthesize(schema, 0)
+}
+
+// synthesize builds a document with every property of schema filled in, up
+// to a depth that keeps recursive schemas finite.
+func (s *contractSpec) synthesize(schema map[string]any, depth int) any {
+	schema = s.resolve(schema)
+	if schema == nil || depth > 6 {
+		return nil
+	}
+
+	for _, combinator := range []string{"anyOf", "oneOf"} {
+		if options, ok := schema[combinator].([]any); ok {
+			return s.synthesize(options[0].(map[string]any), depth)
+		}
+	}
+	if parts, ok := schema["allOf"].([]any); ok {
+		merged := map[string]any{}
+		for _, part := range parts {
+			if object, ok := s.synthesize(part.(map[string]any), depth).(map[string]any); ok {
+				for key, value := range object {
+					merged[key] = value
+				}
+			}
+		}
+		return merged
+	}
+
+	if enum, ok := schema["enum"].([]any); ok && len(enum) > 0 && enum[0] != nil {
+		return enum[0]
+	}
+
+	schemaType := schema["type"]
+	if types, ok := schemaType.([]any); ok {
+		schemaType = types[0]
+	}
+	switch schemaType {
+	case "string":
+		if schema["format"] == "date-time" {
+			return "2024-01-01T00:00:00Z"
+		}
+		return "string"
+	case "integer", "number":
+		if minimum, ok := schema["minimum"]; ok {
+			return minimum
+		}
+		return 1
+	case "boolean":
+		return true
+	case "array":
+		items, _ := schema["items"].(map[string]any)
+		if item := s.synthesize(items, depth+1); item != nil {
+			return []any{item}
+		}
+		return []any{}
+	default:
+		object := map[string]any{}
+		properties, _ := schema["properties"].(map[string]any)
+		for name, property := range properties {
+			if value := s.synthesize(property.(map[string]any), depth+1); value != nil {
+				object[name] = value
+			}
+		}
+		return object
+	}
+}
+
+// normalizeJSON converts a document decoded from YAML into the form
+// encoding/json decodes it to.
+func normalizeJSON(value any) any {
+	rb, _ := json.Marshal(value)
+	var normalized any
+	decoder := json.NewDecoder(bytes.NewReader(rb))
+	decoder.UseNumber()
+	decoder.Decode(&normalized)
+	return normalized
+}
+
+// contractServer is a gateway that checks every request against the spec and
+// answers with documents that follow it.
+type contractServer struct {
+	*httptest.Server
+
+	spec *contractSpec
+
+	mu       sync.Mutex
+	requests []string
+	response string
+}
+
+func newContractServer(t *testing.T) *contractServer {
+	t.Helper()
+
+	server := &contractServer{spec: loadContractSpec(t)}
+	server.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+
+		operation, violations := server.spec.validateRequest(r, body)
+		for _, violation := range violations {
+			t.Errorf("%s %s: %s", r.Method, r.URL.RequestURI(), violation)
+		}
+		if operation.operation == nil {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		status, pointer, ok := server.spec.successResponse(operation)
+		if !ok {
+			t.Errorf("%s %s: the spec documents no success response", r.Method, r.URL.RequestURI())
+			w.WriteHeader(http.StatusNotImplemented)
+			return
+		}
+
+		server.mu.Lock()
+		server.requests = append(server.requests, r.Method+" "+operation.path.template)
+		server.response = pointer
+		server.mu.Unlock()
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		json.NewEncoder(w).Encode(server.spec.responseBody(operation, status, pointer))
+	}))
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+// checkResult validates what the client decoded from the last response
+// against the response schema, so that client fields the spec does not
+// document are reported.
+func (server *contractServer) checkResult(t *testing.T, result any) {
+	t.Helper()
+
+	server.mu.Lock()
+	pointer := server.response
+	server.mu.Unlock()
+
+	if err := server.spec.validate(pointer, normalizeJSON(result)); err != nil {
+		t.Errorf("decoded response: %v", err)
+	}
+}
+
+func TestContract(t *testing.T) {
+	_, certificate, _ := newTestCertificate(t)
+
+	key := Key{
+		"org_id":    "default",
+		"rate":      10,
+		"per":       1,
+		"quota_max": -1,
+		"access_rights": map[string]any{
+			"httpbin-api": map[string]any{
+				"api_id":   "httpbin-api",
+				"api_name": "Httpbin API",
+				"versions": []string{"Default"},
+			},
+		},
+	}
+	api := Api{
+		"name":        "Httpbin API",
+		"api_id":      "httpbin-api",
+		"org_id":      "default",
+		"use_keyless": true,
+		"proxy": map[string]any{
+			"listen_path":       "/httpbin/",
+			"target_url":        "http://httpbin.org",
+			"strip_listen_path": true,
+		},
+	}
+	oasApi := OasApi{
+		"openapi": "3.0.3",
+		"info":    map[string]any{"title": "Httpbin API", "version": "1.0.0"},
+		"paths":   map[string]any{},
+		"x-tyk-api-gateway": map[string]any{
+			"info":     map[string]any{"id": "httpbin-oas", "name": "Httpbin API", "state": map[string]any{"active": true}},
+			"upstream": map[string]any{"url": "http://httpbin.org"},
+			"server":   map[string]any{"listenPath": map[string]any{"value": "/httpbin-oas/", "strip": true}},
+		},
+	}
+	policy := Policy{
+		ID:    "gold",
+		Name:  "Gold",
+		OrgID: "default",
+		Rate:  100,
+		Per:   1,
+		AccessRights: map[string]AccessDefinition{
+			"httpbin-api": {APIID: "httpbin-api", APIName: "Httpbin API", Versions: []string{"Default"}},
+		},
+	}
+	oauthClient := NewClientRequest{
+		ClientID:          "client",
+		APIID:             "httpbin-api",
+		PolicyID:          "gold",
+		ClientRedirectURI: "https://example.com/callback",
+	}
+
+	tests := []struct {
+		name string
+		call func(c *Client) (any, error)
+	}{
+		{"Hello", func(c *Client) (any, error) { return c.Hello() }},
+		{"ReloadGroup", func(c *Client) (any, error) { return c.ReloadGroup() }},
+		{"Reload", func(c *Client) (any, error) { return c.Reload(true) }},
+
+		{"CreateKey", func(c *Client) (any, error) { return c.CreateKey(key) }},
+		{"CreateKeyWithHashed", func(c *Client) (any, error) { return c.CreateKeyWithHashed(key, true) }},
+		{"CreateCustomKey", func(c *Client) (any, error) { return c.CreateCustomKey("custom", key) }},
+		{"GetKey", func(c *Client) (any, error) { return c.GetKey("key") }},
+		{"GetKeyWithHashed", func(c *Client) (any, error) { return c.GetKeyWithHashed("hash", true) }},
+		{"UpdateKey", func(c *Client) (any, error) { return c.UpdateKey("key", key) }},
+		{"UpdateKeyWithHashed", func(c *Client) (any, error) { return c.UpdateKeyWithHashed("hash", key, true) }},
+		{"DeleteKey", func(c *Client) (any, error) { return nil, c.DeleteKey("key") }},
+		{"DeleteKeyWithHashed", func(c *Client) (any, error) { return nil, c.DeleteKeyWithHashed("hash", true) }},
+
+		{"CreateApi", func(c *Client) (any, error) { return c.CreateApi(api) }},
+		{"GetApi", func(c *Client) (any, error) { return c.GetApi("httpbin-api") }},
+		{"UpdateApi", func(c *Client) (any, error) { return c.UpdateApi("httpbin-api", api) }},
+		{"DeleteApi", func(c *Client) (any, error) { return nil, c.DeleteApi("httpbin-api") }},
+
+		{"CreateOasApi", func(c *Client) (any, error) { return c.CreateOasApi(oasApi) }},
+		{"GetOasApi", func(c *Client) (any, error) { return c.GetOasApi("httpbin-oas") }},
+		{"UpdateOasApi", func(c *Client) (any, error) { return c.UpdateOasApi("httpbin-oas", oasApi) }},
+		{"DeleteOasApi", func(c *Client) (any, error) { return nil, c.DeleteOasApi("httpbin-oas") }},
+
+		{"CreatePolicy", func(c *Client) (any, error) { return c.CreatePolicy(policy) }},
+		{"GetPolicy", func(c *Client) (any, error) { return c.GetPolicy("gold") }},
+		{"UpdatePolicy", func(c *Client) (any, error) { return c.UpdatePolicy("gold", policy) }},
+		{"DeletePolicy", func(c *Client) (any, error) { return nil, c.DeletePolicy("gold") }},
+
+		{"CreateCertificate", func(c *Client) (any, error) { return c.CreateCertificate(certificate, "default") }},
+		{"GetCertificate", func(c *Client) (any, error) { return c.GetCertificate("cert") }},
+		{"DeleteCertificate", func(c *Client) (any, error) { return nil, c.DeleteCertificate("cert", "default") }},
+
+		{"CreateOAuthClient", func(c *Client) (any, error) { return c.CreateOAuthClient(oauthClient) }},
+		{"GetOAuthClient", func(c *Client) (any, error) { return c.GetOAuthClient("httpbin-api", "client") }},
+		{"UpdateOAuthClient", func(c *Client) (any, error) { return c.UpdateOAuthClient("httpbin-api", "client", oauthClient) }},
+		{"GetOAuthClientTokens", func(c *Client) (any, error) { return c.GetOAuthClientTokens("httpbin-api", "client") }},
+		{"DeleteOAuthClient", func(c *Client) (any, error) { return nil, c.DeleteOAuthClient("httpbin-api", "client") }},
+
+		{"CreateOrgKey", func(c *Client) (any, error) { return c.CreateOrgKey("default", key, true) }},
+		{"GetOrgKey", func(c *Client) (any, error) { return c.GetOrgKey("default") }},
+		{"UpdateOrgKey", func(c *Client) (any, error) { return c.UpdateOrgKey("default", key, false) }},
+		{"DeleteOrgKey", func(c *Client) (any, error) { return nil, c.DeleteOrgKey("default") }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := newContractServer(t)
+			c, _ := NewClient(server.URL, "secret")
+			c.MaxRetries = 0
+
+			result, err := tt.call(c)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(server.requests) == 0 {
+				t.Fatal("expected a request")
+			}
+			if result != nil {
+				server.checkResult(t, result)
+			}
+		})
+	}
+}
+
+func TestContractViolations(t *testing.T) {
+	spec := loadContractSpec(t)
+
+	tests := []struct {
+		name      string
+		method    string
+		target    string
+		body      string
+		violation string
+	}{
+		{"unknown path", "GET", "/tyk/key/abc", "", "is not in the spec"},
+		{"unknown method", "PATCH", "/tyk/policies/gold", "", "is not in the spec"},
+		{"misspelled query parameter", "GET", "/tyk/keys/abc?hash=true", "", "query parameter hash is not in the spec"},
+		{"invalid boolean", "GET", "/tyk/keys/abc?hashed=1", "", "query parameter hashed=1"},
+		{"invalid enum", "GET", "/tyk/certs?mode=brief", "", "query parameter mode=brief"},
+		{"unexpected body", "GET", "/tyk/policies/gold", `{}`, "takes no request body"},
+		{"malformed body", "POST", "/tyk/policies", `{`, "not JSON"},
+		{"wrong field type", "POST", "/tyk/policies", `{"rate":"fast"}`, "request body"},
+		{"undocumented field", "POST", "/tyk/keys?hashed=true", `{"quota_remainig":10}`, "request body"},
+		{"empty certificate", "POST", "/tyk/certs", "", "request body is empty"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest(tt.method, tt.target, strings.NewReader(tt.body))
+			_, violations := spec.validateRequest(r, []byte(tt.body))
+			if !slices.ContainsFunc(violations, func(violation string) bool { return strings.Contains(violation, tt.violation) }) {
+				t.Errorf("expected a violation containing %q, got %q", tt.violation, violations)
+			}
+		})
+	}
+
+	// The documented form of the same requests passes.
+	for _, target := range []string{"/tyk/keys/abc?hashed=true", "/tyk/certs?mode=detailed"} {
+		r := httptest.NewRequest("GET", target, nil)
+		if _, violations := spec.validateRequest(r, nil); len(violations) != 0 {
+			t.Errorf("GET %s: unexpected violations %q", target, violations)
+		}
+	}
+}
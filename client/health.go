@@ -0,0 +1,166 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+const (
+	HealthStatusPass = "pass"
+	HealthStatusWarn = "warn"
+	HealthStatusFail = "fail"
+)
+
+// DefaultLiveTimeout bounds the wait for a created API to be loaded.
+const DefaultLiveTimeout = 1 * time.Minute
+
+// pollInterval is the delay between two polls of the gateway.
+var pollInterval = 1 * time.Second
+
+type HealthCheckResponse struct {
+	Status      string                     `json:"status"`
+	Version     string                     `json:"version,omitempty"`
+	Output      string                     `json:"output,omitempty"`
+	Description string                     `json:"description,omitempty"`
+	Details     map[string]HealthCheckItem `json:"details,omitempty"`
+
+	// Unknown holds the fields of newer gateways.
+	Unknown UnknownFields `json:"-"`
+}
+
+func (h *HealthCheckResponse) UnmarshalJSON(data []byte) error {
+	type healthCheckResponse HealthCheckResponse
+	unknown, err := unmarshalWithUnknown(data, (*healthCheckResponse)(h))
+	h.Unknown = unknown
+	return err
+}
+
+func (h HealthCheckResponse) MarshalJSON() ([]byte, error) {
+	type healthCheckResponse HealthCheckResponse
+	return marshalWithUnknown(healthCheckResponse(h), h.Unknown)
+}
+
+type HealthCheckItem struct {
+	Status        string `json:"status"`
+	Output        string `json:"output,omitempty"`
+	ComponentType string `json:"componentType,omitempty"`
+	ComponentID   string `json:"componentId,omitempty"`
+	Time          string `json:"time,omitempty"`
+}
+
+func (c *Client) Hello() (HealthCheckResponse, error) {
+	return c.HelloContext(context.Background())
+}
+
+func (c *Client) HelloContext(ctx context.Context) (HealthCheckResponse, error) {
+	if c.isCluster() {
+		return fanOutAll(c, func(node *Client) (HealthCheckResponse, error) { return node.HelloContext(ctx) })
+	}
+
+	var healthCheckResponse HealthCheckResponse
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/hello", c.Host), nil)
+	if err != nil {
+		return healthCheckResponse, err
+	}
+	body, err := c.doRequest(req)
+	if err != nil {
+		return healthCheckResponse, err
+	}
+
+	err = json.Unmarshal(body, &healthCheckResponse)
+	if err != nil {
+		return HealthCheckResponse{}, err
+	}
+
+	// A gateway can answer while one of its components has failed.
+	if healthCheckResponse.Status == HealthStatusFail {
+		return healthCheckResponse, fmt.Errorf("health check failed: %s", healthCheckResponse.Output)
+	}
+
+	return healthCheckResponse, nil
+}
+
+// WaitForReadyContext polls /hello until the gateway, or every gateway of a
+// cluster, is healthy, for at most timeout.
+func (c *Client) WaitForReadyContext(ctx context.Context, timeout time.Duration) error {
+	return poll(ctx, timeout,
+		func(ctx context.Context) error {
+			_, err := c.HelloContext(ctx)
+			return err
+		},
+		// The gateway is expected to be unreachable while it starts.
+		func(err error) bool { return true },
+	)
+}
+
+// WaitForApiContext polls until the gateway, or every gateway of a cluster,
+// has loaded the API, for at most timeout. The gateway only serves the APIs it
+// has loaded, so the API is not found until then, or only found on the nodes
+// of a cluster that have loaded it.
+func (c *Client) WaitForApiContext(ctx context.Context, apiId string, timeout time.Duration) error {
+	return poll(ctx, timeout,
+		func(ctx context.Context) error {
+			_, err := c.GetApiContext(ctx, apiId)
+			return err
+		},
+		notLoaded,
+	)
+}
+
+// WaitForOasApiContext polls until the gateway, or every gateway of a
+// cluster, has loaded the OAS API, for at most timeout.
+func (c *Client) WaitForOasApiContext(ctx context.Context, apiId string, timeout time.Duration) error {
+	return poll(ctx, timeout,
+		func(ctx context.Context) error {
+			_, err := c.GetOasApiContext(ctx, apiId)
+			return err
+		},
+		notLoaded,
+	)
+}
+
+// notLoaded reports whether err means that the gateway, or some gateway of a
+// cluster, has not loaded an API yet.
+func notLoaded(err error) bool {
+	return IsNotFound(err) || errors.Is(err, ErrMissingOnSomeNodes)
+}
+
+// poll calls check until it succeeds, for at most timeout. Errors for which
+// pending reports true mean the gateway is not there yet and are only
+// returned when the time is up.
+func poll(ctx context.Context, timeout time.Duration, check func(ctx context.Context) error, pending func(err error) bool) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	var last error
+	for {
+		err := check(ctx)
+		if err == nil {
+			return nil
+		}
+		// A check cut short by the timeout says nothing about the gateway,
+		// report the one before it instead, or that there was none.
+		if ctx.Err() != nil {
+			if last == nil {
+				return fmt.Errorf("timed out after %s waiting for the first answer: %w", timeout, err)
+			}
+			return fmt.Errorf("timed out after %s: %w", timeout, last)
+		}
+		if !pending(err) {
+			return err
+		}
+		last = err
+
+		timer := time.NewTimer(pollInterval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return fmt.Errorf("timed out after %s: %w", timeout, err)
+		case <-timer.C:
+		}
+	}
+}
@@ -0,0 +1,139 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"terraform-provider-tykgateway/internal/fakegateway"
+	"testing"
+	"time"
+)
+
+func init() {
+	pollInterval = 10 * time.Millisecond
+}
+
+func TestWaitForReady(t *testing.T) {
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/hello" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		// The first answers come from a gateway whose redis is not up yet.
+		if requests.Add(1) < 3 {
+			w.Write([]byte(`{"status":"fail","output":"redis unavailable"}`))
+			return
+		}
+		w.Write([]byte(`{"status":"pass","version":"v5.8.0"}`))
+	}))
+	t.Cleanup(server.Close)
+
+	c, _ := NewClient(server.URL, "secret")
+	if err := c.WaitForReadyContext(context.Background(), time.Second); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := requests.Load(); got != 3 {
+		t.Errorf("expected 3 health checks, got %d", got)
+	}
+}
+
+func TestWaitForReadyTimeout(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"status":"fail","output":"redis unavailable"}`))
+	}))
+	t.Cleanup(server.Close)
+
+	c, _ := NewClient(server.URL, "secret")
+	err := c.WaitForReadyContext(context.Background(), 50*time.Millisecond)
+	if err == nil || !strings.Contains(err.Error(), "redis unavailable") {
+		t.Errorf("expected a timeout with the last health check, got %v", err)
+	}
+}
+
+func TestWaitForApi(t *testing.T) {
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// The API is only served once the gateway has reloaded.
+		if requests.Add(1) < 3 {
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"status":"error","message":"API not found"}`))
+			return
+		}
+		w.Write([]byte(`{"api_id":"api1"}`))
+	}))
+	t.Cleanup(server.Close)
+
+	c, _ := NewClient(server.URL, "secret")
+	if err := c.WaitForApiContext(context.Background(), "api1", time.Second); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := requests.Load(); got != 3 {
+		t.Errorf("expected 3 requests, got %d", got)
+	}
+}
+
+func TestWaitForApiError(t *testing.T) {
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		w.WriteHeader(http.StatusForbidden)
+	}))
+	t.Cleanup(server.Close)
+
+	c, _ := NewClient(server.URL, "secret")
+	err := c.WaitForApiContext(context.Background(), "api1", time.Second)
+	if !IsUnauthorized(err) {
+		t.Fatalf("expected an unauthorized error, got %v", err)
+	}
+	if got := requests.Load(); got != 1 {
+		t.Errorf("expected errors other than not found to end the wait, got %d requests", got)
+	}
+}
+
+func TestWaitForApiFirstCheckTimeout(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// The gateway does not answer before the deadline.
+		select {
+		case <-r.Context().Done():
+		case <-time.After(time.Second):
+		}
+		w.Write([]byte(`{"api_id":"api1"}`))
+	}))
+	t.Cleanup(server.Close)
+
+	c, _ := NewClient(server.URL, "secret")
+	err := c.WaitForApiContext(context.Background(), "api1", 50*time.Millisecond)
+	if err == nil || !strings.Contains(err.Error(), "timed out after 50ms waiting for the first answer") {
+		t.Errorf("expected a timeout without an answer, got %v", err)
+	}
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("expected the deadline to be exceeded, got %v", err)
+	}
+}
+
+func TestWaitForApiCluster(t *testing.T) {
+	first, second := fakegateway.New("secret"), fakegateway.New("secret")
+	t.Cleanup(first.Close)
+	t.Cleanup(second.Close)
+
+	c, err := NewClusterClient([]string{first.URL, second.URL}, "secret")
+	if err != nil {
+		t.Fatal(err)
+	}
+	response, err := c.CreateApi(Api{"name": "httpbin"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// The second node loads the API after the first one.
+	first.Reload()
+	time.AfterFunc(50*time.Millisecond, second.Reload)
+
+	if err := c.WaitForApiContext(context.Background(), response.Key, time.Second); err != nil {
+		t.Fatalf("expected the wait to last until every node has loaded the API, got %v", err)
+	}
+}
@@ -1,6 +1,7 @@
 package client
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -94,10 +95,92 @@ type AccessDefinition struct {
 	DisableIntrospection bool                    `json:"disable_introspection,omitempty" tfsdk:"disable_introspection"`
 	AllowanceScope       string                  `json:"allowance_scope,omitempty" tfsdk:"allowance_scope"`
 	Endpoints            Endpoints               `json:"endpoints,omitempty" tfsdk:"endpoints"`
+	Unknown              UnknownFields           `json:"-" tfsdk:"-"`
+}
+
+func (a *AccessDefinition) UnmarshalJSON(data []byte) error {
+	type accessDefinition AccessDefinition
+	unknown, err := unmarshalWithUnknown(data, (*accessDefinition)(a))
+	a.Unknown = unknown
+	return err
+}
+
+func (a AccessDefinition) MarshalJSON() ([]byte, error) {
+	type accessDefinition AccessDefinition
+	return marshalWithUnknown(accessDefinition(a), a.Unknown)
 }
 
 type Key map[string]any
 
+// SessionState is the typed form of a key session, the SessionState schema of
+// the gateway.
+type SessionState struct {
+	OrgID                         string                      `json:"org_id,omitempty"`
+	Alias                         string                      `json:"alias,omitempty"`
+	Allowance                     float64                     `json:"allowance,omitempty"`
+	Rate                          float64                     `json:"rate,omitempty"`
+	Per                           float64                     `json:"per,omitempty"`
+	ThrottleInterval              float64                     `json:"throttle_interval,omitempty"`
+	ThrottleRetryLimit            int                         `json:"throttle_retry_limit,omitempty"`
+	MaxQueryDepth                 int                         `json:"max_query_depth,omitempty"`
+	Smoothing                     *RateLimitSmoothing         `json:"smoothing,omitempty"`
+	Expires                       int64                       `json:"expires,omitempty"`
+	DataExpires                   int64                       `json:"data_expires,omitempty"`
+	SessionLifetime               int64                       `json:"session_lifetime,omitempty"`
+	QuotaMax                      int64                       `json:"quota_max,omitempty"`
+	QuotaRenews                   int64                       `json:"quota_renews,omitempty"`
+	QuotaRemaining                int64                       `json:"quota_remaining,omitempty"`
+	QuotaRenewalRate              int64                       `json:"quota_renewal_rate,omitempty"`
+	AccessRights                  map[string]AccessDefinition `json:"access_rights,omitempty"`
+	HMACEnabled                   bool                        `json:"hmac_enabled,omitempty"`
+	HmacSecret                    string                      `json:"hmac_string,omitempty"`
+	EnableHTTPSignatureValidation bool                        `json:"enable_http_signature_validation,omitempty"`
+	RSACertificateID              string                      `json:"rsa_certificate_id,omitempty"`
+	Certificate                   string                      `json:"certificate,omitempty"`
+	BasicAuthData                 *BasicAuthData              `json:"basic_auth_data,omitempty"`
+	JWTData                       *JWTData                    `json:"jwt_data,omitempty"`
+	OauthClientID                 string                      `json:"oauth_client_id,omitempty"`
+	OauthKeys                     map[string]string           `json:"oauth_keys,omitempty"`
+	Monitor                       *Monitor                    `json:"monitor,omitempty"`
+	EnableDetailRecording         bool                        `json:"enable_detail_recording,omitempty"`
+	EnableDetailedRecording       bool                        `json:"enable_detailed_recording,omitempty"`
+	MetaData                      map[string]any              `json:"meta_data,omitempty"`
+	Tags                          []string                    `json:"tags,omitempty"`
+	ApplyPolicies                 []string                    `json:"apply_policies,omitempty"`
+	// Deprecated: use ApplyPolicies.
+	ApplyPolicyID       string `json:"apply_policy_id,omitempty"`
+	IsInactive          bool   `json:"is_inactive,omitempty"`
+	IDExtractorDeadline int64  `json:"id_extractor_deadline,omitempty"`
+	LastCheck           int64  `json:"last_check,omitempty"`
+	DateCreated         string `json:"date_created,omitempty"`
+	LastUpdated         string `json:"last_updated,omitempty"`
+
+	// Unknown holds the fields of newer gateways.
+	Unknown UnknownFields `json:"-"`
+}
+
+func (s *SessionState) UnmarshalJSON(data []byte) error {
+	type sessionState SessionState
+	unknown, err := unmarshalWithUnknown(data, (*sessionState)(s))
+	s.Unknown = unknown
+	return err
+}
+
+func (s SessionState) MarshalJSON() ([]byte, error) {
+	type sessionState SessionState
+	return marshalWithUnknown(sessionState(s), s.Unknown)
+}
+
+// Key converts the session into the untyped form the key endpoints take.
+func (s SessionState) Key() (Key, error) {
+	return convertJSON[Key](s)
+}
+
+// SessionState converts the untyped key into its typed form.
+func (k Key) SessionState() (SessionState, error) {
+	return convertJSON[SessionState](k)
+}
+
 type ApiModifyKeySuccess struct {
 	Key     string `json:"key"`
 	Status  string `json:"status"`
@@ -106,10 +189,33 @@ type ApiModifyKeySuccess struct {
 }
 
 func (c *Client) CreateKey(key Key) (ApiModifyKeySuccess, error) {
-	return c.CreateKeyWithHashed(key, false)
+	return c.CreateKeyContext(context.Background(), key)
+}
+
+func (c *Client) CreateKeyContext(ctx context.Context, key Key) (ApiModifyKeySuccess, error) {
+	return c.CreateKeyWithHashedContext(ctx, key, false)
 }
 
 func (c *Client) CreateKeyWithHashed(key Key, hashed bool) (ApiModifyKeySuccess, error) {
+	return c.CreateKeyWithHashedContext(context.Background(), key, hashed)
+}
+
+func (c *Client) CreateKeyWithHashedContext(ctx context.Context, key Key, hashed bool) (ApiModifyKeySuccess, error) {
+	if c.isCluster() {
+		return fanOutCreate(c,
+			func(node *Client) (ApiModifyKeySuccess, error) {
+				return node.CreateKeyWithHashedContext(ctx, key, hashed)
+			},
+			func(node *Client, first ApiModifyKeySuccess) (ApiModifyKeySuccess, error) {
+				// Every node gets the key generated by the first one.
+				return node.CreateCustomKeyWithHashedContext(ctx, first.Key, key, hashed)
+			},
+			func(node *Client, created ApiModifyKeySuccess) error {
+				return node.DeleteKeyWithHashedContext(ctx, created.Key, false)
+			},
+		)
+	}
+
 	var apiModifyKeySuccess ApiModifyKeySuccess
 
 	rb, err := json.Marshal(key)
@@ -117,12 +223,66 @@ func (c *Client) CreateKeyWithHashed(key Key, hashed bool) (ApiModifyKeySuccess,
 		return apiModifyKeySuccess, err
 	}
 
-	req, err := http.NewRequest("POST", fmt.Sprintf("%s/tyk/keys?hashed=%t", c.Host, hashed), strings.NewReader(string(rb)))
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/tyk/keys?hashed=%t", c.Host, hashed), strings.NewReader(string(rb)))
 	if err != nil {
 		return apiModifyKeySuccess, err
 	}
 
-	body, err := c.doRequest(req)
+	// Depending on its version, the gateway answers with 201 Created.
+	body, err := c.doRequest(req, http.StatusOK, http.StatusCreated)
+	if err != nil {
+		return apiModifyKeySuccess, err
+	}
+
+	err = json.Unmarshal(body, &apiModifyKeySuccess)
+	if err != nil {
+		return ApiModifyKeySuccess{}, err
+	}
+
+	return apiModifyKeySuccess, nil
+}
+
+// CreateCustomKey creates a key with the given ID instead of a generated one.
+func (c *Client) CreateCustomKey(keyId string, key Key) (ApiModifyKeySuccess, error) {
+	return c.CreateCustomKeyContext(context.Background(), keyId, key)
+}
+
+func (c *Client) CreateCustomKeyContext(ctx context.Context, keyId string, key Key) (ApiModifyKeySuccess, error) {
+	return c.CreateCustomKeyWithHashedContext(ctx, keyId, key, false)
+}
+
+func (c *Client) CreateCustomKeyWithHashed(keyId string, key Key, hashed bool) (ApiModifyKeySuccess, error) {
+	return c.CreateCustomKeyWithHashedContext(context.Background(), keyId, key, hashed)
+}
+
+func (c *Client) CreateCustomKeyWithHashedContext(ctx context.Context, keyId string, key Key, hashed bool) (ApiModifyKeySuccess, error) {
+	if c.isCluster() {
+		return fanOutCreate(c,
+			func(node *Client) (ApiModifyKeySuccess, error) {
+				return node.CreateCustomKeyWithHashedContext(ctx, keyId, key, hashed)
+			},
+			func(node *Client, first ApiModifyKeySuccess) (ApiModifyKeySuccess, error) {
+				return node.CreateCustomKeyWithHashedContext(ctx, keyId, key, hashed)
+			},
+			func(node *Client, created ApiModifyKeySuccess) error {
+				return node.DeleteKeyWithHashedContext(ctx, keyId, false)
+			},
+		)
+	}
+
+	var apiModifyKeySuccess ApiModifyKeySuccess
+
+	rb, err := json.Marshal(key)
+	if err != nil {
+		return apiModifyKeySuccess, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/tyk/keys/%s?hashed=%t", c.Host, keyId, hashed), strings.NewReader(string(rb)))
+	if err != nil {
+		return apiModifyKeySuccess, err
+	}
+
+	body, err := c.doRequest(req, http.StatusOK, http.StatusCreated)
 	if err != nil {
 		return apiModifyKeySuccess, err
 	}
@@ -136,12 +296,24 @@ func (c *Client) CreateKeyWithHashed(key Key, hashed bool) (ApiModifyKeySuccess,
 }
 
 func (c *Client) GetKey(keyId string) (Key, error) {
-	return c.GetKeyWithHashed(keyId, false)
+	return c.GetKeyContext(context.Background(), keyId)
+}
+
+func (c *Client) GetKeyContext(ctx context.Context, keyId string) (Key, error) {
+	return c.GetKeyWithHashedContext(ctx, keyId, false)
 }
 
 func (c *Client) GetKeyWithHashed(keyId string, hashed bool) (Key, error) {
+	return c.GetKeyWithHashedContext(context.Background(), keyId, hashed)
+}
+
+func (c *Client) GetKeyWithHashedContext(ctx context.Context, keyId string, hashed bool) (Key, error) {
+	if c.isCluster() {
+		return fanOutRead(c, func(node *Client) (Key, error) { return node.GetKeyWithHashedContext(ctx, keyId, hashed) })
+	}
+
 	var key Key
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/tyk/keys/%s?hashed=%t", c.Host, keyId, hashed), nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/tyk/keys/%s?hashed=%t", c.Host, keyId, hashed), nil)
 	if err != nil {
 		return key, err
 	}
@@ -158,11 +330,36 @@ func (c *Client) GetKeyWithHashed(keyId string, hashed bool) (Key, error) {
 }
 
 func (c *Client) DeleteKey(keyId string) error {
-	return c.DeleteKeyWithHashed(keyId, false)
+	return c.DeleteKeyContext(context.Background(), keyId)
+}
+
+func (c *Client) DeleteKeyContext(ctx context.Context, keyId string) error {
+	return c.DeleteKeyWithHashedContext(ctx, keyId, false)
 }
 
 func (c *Client) DeleteKeyWithHashed(keyId string, hashed bool) error {
-	req, err := http.NewRequest("DELETE", fmt.Sprintf("%s/tyk/keys/%s?hashed=%t", c.Host, keyId, hashed), nil)
+	return c.DeleteKeyWithHashedContext(context.Background(), keyId, hashed)
+}
+
+func (c *Client) DeleteKeyWithHashedContext(ctx context.Context, keyId string, hashed bool) error {
+	if c.isCluster() {
+		// A key deleted by its hash cannot be recreated, the key itself is
+		// needed for that.
+		var restore func(node *Client, previous Key) error
+		if !hashed {
+			restore = func(node *Client, previous Key) error {
+				_, err := node.CreateCustomKeyWithHashedContext(ctx, keyId, previous, false)
+				return err
+			}
+		}
+		return fanOutDelete(c,
+			func(node *Client) (Key, error) { return node.GetKeyWithHashedContext(ctx, keyId, hashed) },
+			func(node *Client) error { return node.DeleteKeyWithHashedContext(ctx, keyId, hashed) },
+			restore,
+		)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "DELETE", fmt.Sprintf("%s/tyk/keys/%s?hashed=%t", c.Host, keyId, hashed), nil)
 	if err != nil {
 		return err
 	}
@@ -176,10 +373,31 @@ func (c *Client) DeleteKeyWithHashed(keyId string, hashed bool) error {
 }
 
 func (c *Client) UpdateKey(keyId string, key Key) (ApiModifyKeySuccess, error) {
-	return c.UpdateKeyWithHashed(keyId, key, false)
+	return c.UpdateKeyContext(context.Background(), keyId, key)
+}
+
+func (c *Client) UpdateKeyContext(ctx context.Context, keyId string, key Key) (ApiModifyKeySuccess, error) {
+	return c.UpdateKeyWithHashedContext(ctx, keyId, key, false)
 }
 
 func (c *Client) UpdateKeyWithHashed(keyId string, key Key, hashed bool) (ApiModifyKeySuccess, error) {
+	return c.UpdateKeyWithHashedContext(context.Background(), keyId, key, hashed)
+}
+
+func (c *Client) UpdateKeyWithHashedContext(ctx context.Context, keyId string, key Key, hashed bool) (ApiModifyKeySuccess, error) {
+	if c.isCluster() {
+		return fanOutUpdate(c,
+			func(node *Client) (Key, error) { return node.GetKeyWithHashedContext(ctx, keyId, hashed) },
+			func(node *Client) (ApiModifyKeySuccess, error) {
+				return node.UpdateKeyWithHashedContext(ctx, keyId, key, hashed)
+			},
+			func(node *Client, previous Key) error {
+				_, err := node.UpdateKeyWithHashedContext(ctx, keyId, previous, hashed)
+				return err
+			},
+		)
+	}
+
 	var apiModifyKeySuccess ApiModifyKeySuccess
 
 	rb, err := json.Marshal(key)
@@ -187,7 +405,7 @@ func (c *Client) UpdateKeyWithHashed(keyId string, key Key, hashed bool) (ApiMod
 		return apiModifyKeySuccess, err
 	}
 
-	req, err := http.NewRequest("PUT", fmt.Sprintf("%s/tyk/keys/%s?hashed=%t", c.Host, keyId, hashed), strings.NewReader(string(rb)))
+	req, err := http.NewRequestWithContext(ctx, "PUT", fmt.Sprintf("%s/tyk/keys/%s?hashed=%t", c.Host, keyId, hashed), strings.NewReader(string(rb)))
 	if err != nil {
 		return apiModifyKeySuccess, err
 	}
@@ -0,0 +1,188 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+type OasApi map[string]any
+
+func (c *Client) CreateOasApi(api OasApi) (ApiModifyKeySuccess, error) {
+	return c.CreateOasApiContext(context.Background(), api)
+}
+
+func (c *Client) CreateOasApiContext(ctx context.Context, api OasApi) (ApiModifyKeySuccess, error) {
+	if c.isCluster() {
+		return fanOutCreate(c,
+			func(node *Client) (ApiModifyKeySuccess, error) { return node.CreateOasApiContext(ctx, api) },
+			func(node *Client, first ApiModifyKeySuccess) (ApiModifyKeySuccess, error) {
+				// Every node gets the API ID generated by the first one.
+				api, err := withOasApiId(api, first.Key)
+				if err != nil {
+					return ApiModifyKeySuccess{}, err
+				}
+				return node.CreateOasApiContext(ctx, api)
+			},
+			func(node *Client, created ApiModifyKeySuccess) error {
+				return node.DeleteOasApiContext(ctx, created.Key)
+			},
+		)
+	}
+
+	var apiModifyKeySuccess ApiModifyKeySuccess
+
+	rb, err := json.Marshal(api)
+	if err != nil {
+		return apiModifyKeySuccess, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/tyk/apis/oas", c.Host), strings.NewReader(string(rb)))
+	if err != nil {
+		return apiModifyKeySuccess, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return apiModifyKeySuccess, err
+	}
+
+	err = json.Unmarshal(body, &apiModifyKeySuccess)
+	if err != nil {
+		return ApiModifyKeySuccess{}, err
+	}
+
+	return apiModifyKeySuccess, nil
+}
+
+func (c *Client) GetOasApi(apiId string) (OasApi, error) {
+	return c.GetOasApiContext(context.Background(), apiId)
+}
+
+func (c *Client) GetOasApiContext(ctx context.Context, apiId string) (OasApi, error) {
+	if c.isCluster() {
+		return fanOutRead(c, func(node *Client) (OasApi, error) { return node.GetOasApiContext(ctx, apiId) })
+	}
+
+	var api OasApi
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/tyk/apis/oas/%s", c.Host, apiId), nil)
+	if err != nil {
+		return api, err
+	}
+	body, err := c.doRequest(req)
+	if err != nil {
+		return api, err
+	}
+
+	err = json.Unmarshal(body, &api)
+	if err != nil {
+		return api, err
+	}
+	return api, nil
+}
+
+func (c *Client) UpdateOasApi(apiId string, api OasApi) (ApiModifyKeySuccess, error) {
+	return c.UpdateOasApiContext(context.Background(), apiId, api)
+}
+
+func (c *Client) UpdateOasApiContext(ctx context.Context, apiId string, api OasApi) (ApiModifyKeySuccess, error) {
+	if c.isCluster() {
+		return fanOutUpdate(c,
+			func(node *Client) (OasApi, error) { return node.GetOasApiContext(ctx, apiId) },
+			func(node *Client) (ApiModifyKeySuccess, error) { return node.UpdateOasApiContext(ctx, apiId, api) },
+			func(node *Client, previous OasApi) error {
+				_, err := node.UpdateOasApiContext(ctx, apiId, previous)
+				return err
+			},
+		)
+	}
+
+	var apiModifyKeySuccess ApiModifyKeySuccess
+
+	// The gateway rejects updates whose body API ID differs from the path.
+	api, err := withOasApiId(api, apiId)
+	if err != nil {
+		return apiModifyKeySuccess, err
+	}
+
+	rb, err := json.Marshal(api)
+	if err != nil {
+		return apiModifyKeySuccess, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "PUT", fmt.Sprintf("%s/tyk/apis/oas/%s", c.Host, apiId), strings.NewReader(string(rb)))
+	if err != nil {
+		return apiModifyKeySuccess, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return apiModifyKeySuccess, err
+	}
+
+	err = json.Unmarshal(body, &apiModifyKeySuccess)
+	if err != nil {
+		return ApiModifyKeySuccess{}, err
+	}
+
+	return apiModifyKeySuccess, nil
+}
+
+func (c *Client) DeleteOasApi(apiId string) error {
+	return c.DeleteOasApiContext(context.Background(), apiId)
+}
+
+func (c *Client) DeleteOasApiContext(ctx context.Context, apiId string) error {
+	if c.isCluster() {
+		return fanOutDelete(c,
+			func(node *Client) (OasApi, error) { return node.GetOasApiContext(ctx, apiId) },
+			func(node *Client) error { return node.DeleteOasApiContext(ctx, apiId) },
+			func(node *Client, previous OasApi) error {
+				_, err := node.CreateOasApiContext(ctx, previous)
+				return err
+			},
+		)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "DELETE", fmt.Sprintf("%s/tyk/apis/oas/%s", c.Host, apiId), nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.doRequest(req)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// withOasApiId returns a copy of api with its Tyk API ID set to apiId.
+func withOasApiId(api OasApi, apiId string) (OasApi, error) {
+	rb, err := json.Marshal(api)
+	if err != nil {
+		return nil, err
+	}
+
+	var result OasApi
+	err = json.Unmarshal(rb, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	extension, _ := result["x-tyk-api-gateway"].(map[string]any)
+	if extension == nil {
+		extension = map[string]any{}
+		result["x-tyk-api-gateway"] = extension
+	}
+	info, _ := extension["info"].(map[string]any)
+	if info == nil {
+		info = map[string]any{}
+		extension["info"] = info
+	}
+	info["id"] = apiId
+
+	return result, nil
+}
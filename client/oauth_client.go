@@ -0,0 +1,229 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+type NewClientRequest struct {
+	ClientID          string            `json:"client_id,omitempty"`
+	ClientRedirectURI string            `json:"redirect_uri"`
+	APIID             string            `json:"api_id,omitempty"`
+	PolicyID          string            `json:"policy_id,omitempty"`
+	ClientSecret      string            `json:"secret,omitempty"`
+	MetaData          map[string]string `json:"meta_data,omitempty"`
+	Description       string            `json:"description,omitempty"`
+}
+
+// OAuthClientToken is a token the gateway issued to an OAuth client.
+type OAuthClientToken struct {
+	Code    string `json:"code"`
+	Expires int64  `json:"expires"`
+
+	// Unknown holds the fields of newer gateways.
+	Unknown UnknownFields `json:"-"`
+}
+
+func (t *OAuthClientToken) UnmarshalJSON(data []byte) error {
+	type oauthClientToken OAuthClientToken
+	unknown, err := unmarshalWithUnknown(data, (*oauthClientToken)(t))
+	t.Unknown = unknown
+	return err
+}
+
+func (t OAuthClientToken) MarshalJSON() ([]byte, error) {
+	type oauthClientToken OAuthClientToken
+	return marshalWithUnknown(oauthClientToken(t), t.Unknown)
+}
+
+func (c *Client) CreateOAuthClient(oauthClient NewClientRequest) (NewClientRequest, error) {
+	return c.CreateOAuthClientContext(context.Background(), oauthClient)
+}
+
+func (c *Client) CreateOAuthClientContext(ctx context.Context, oauthClient NewClientRequest) (NewClientRequest, error) {
+	if c.isCluster() {
+		return fanOutCreate(c,
+			func(node *Client) (NewClientRequest, error) { return node.CreateOAuthClientContext(ctx, oauthClient) },
+			func(node *Client, first NewClientRequest) (NewClientRequest, error) {
+				// Every node gets the client ID and secret generated by the first one.
+				oauthClient := oauthClient
+				oauthClient.ClientID = first.ClientID
+				oauthClient.ClientSecret = first.ClientSecret
+				return node.CreateOAuthClientContext(ctx, oauthClient)
+			},
+			func(node *Client, created NewClientRequest) error {
+				return node.DeleteOAuthClientContext(ctx, oauthClient.APIID, created.ClientID)
+			},
+		)
+	}
+
+	var newClientRequest NewClientRequest
+
+	rb, err := json.Marshal(oauthClient)
+	if err != nil {
+		return newClientRequest, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/tyk/oauth/clients/create", c.Host), strings.NewReader(string(rb)))
+	if err != nil {
+		return newClientRequest, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return newClientRequest, err
+	}
+
+	err = json.Unmarshal(body, &newClientRequest)
+	if err != nil {
+		return NewClientRequest{}, err
+	}
+
+	return newClientRequest, nil
+}
+
+func (c *Client) GetOAuthClient(apiId string, clientId string) (NewClientRequest, error) {
+	return c.GetOAuthClientContext(context.Background(), apiId, clientId)
+}
+
+func (c *Client) GetOAuthClientContext(ctx context.Context, apiId string, clientId string) (NewClientRequest, error) {
+	if c.isCluster() {
+		return fanOutRead(c, func(node *Client) (NewClientRequest, error) {
+			return node.GetOAuthClientContext(ctx, apiId, clientId)
+		})
+	}
+
+	var newClientRequest NewClientRequest
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/tyk/oauth/clients/%s/%s", c.Host, apiId, clientId), nil)
+	if err != nil {
+		return newClientRequest, err
+	}
+	body, err := c.doRequest(req)
+	if err != nil {
+		return newClientRequest, err
+	}
+
+	err = json.Unmarshal(body, &newClientRequest)
+	if err != nil {
+		return newClientRequest, err
+	}
+	return newClientRequest, nil
+}
+
+func (c *Client) UpdateOAuthClient(apiId string, clientId string, oauthClient NewClientRequest) (NewClientRequest, error) {
+	return c.UpdateOAuthClientContext(context.Background(), apiId, clientId, oauthClient)
+}
+
+func (c *Client) UpdateOAuthClientContext(ctx context.Context, apiId string, clientId string, oauthClient NewClientRequest) (NewClientRequest, error) {
+	if c.isCluster() {
+		return fanOutUpdate(c,
+			func(node *Client) (NewClientRequest, error) { return node.GetOAuthClientContext(ctx, apiId, clientId) },
+			func(node *Client) (NewClientRequest, error) {
+				return node.UpdateOAuthClientContext(ctx, apiId, clientId, oauthClient)
+			},
+			func(node *Client, previous NewClientRequest) error {
+				_, err := node.UpdateOAuthClientContext(ctx, apiId, clientId, previous)
+				return err
+			},
+		)
+	}
+
+	var newClientRequest NewClientRequest
+
+	rb, err := json.Marshal(oauthClient)
+	if err != nil {
+		return newClientRequest, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "PUT", fmt.Sprintf("%s/tyk/oauth/clients/%s/%s", c.Host, apiId, clientId), strings.NewReader(string(rb)))
+	if err != nil {
+		return newClientRequest, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return newClientRequest, err
+	}
+
+	err = json.Unmarshal(body, &newClientRequest)
+	if err != nil {
+		return NewClientRequest{}, err
+	}
+
+	return newClientRequest, nil
+}
+
+func (c *Client) DeleteOAuthClient(apiId string, clientId string) error {
+	return c.DeleteOAuthClientContext(context.Background(), apiId, clientId)
+}
+
+func (c *Client) DeleteOAuthClientContext(ctx context.Context, apiId string, clientId string) error {
+	if c.isCluster() {
+		return fanOutDelete(c,
+			func(node *Client) (NewClientRequest, error) { return node.GetOAuthClientContext(ctx, apiId, clientId) },
+			func(node *Client) error { return node.DeleteOAuthClientContext(ctx, apiId, clientId) },
+			func(node *Client, previous NewClientRequest) error {
+				previous.APIID = apiId
+				_, err := node.CreateOAuthClientContext(ctx, previous)
+				return err
+			},
+		)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "DELETE", fmt.Sprintf("%s/tyk/oauth/clients/%s/%s", c.Host, apiId, clientId), nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.doRequest(req)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (c *Client) GetOAuthClientTokens(apiId string, clientId string) ([]OAuthClientToken, error) {
+	return c.GetOAuthClientTokensContext(context.Background(), apiId, clientId)
+}
+
+func (c *Client) GetOAuthClientTokensContext(ctx context.Context, apiId string, clientId string) ([]OAuthClientToken, error) {
+	if c.isCluster() {
+		// The gateways of a cluster share their tokens through Redis, any
+		// node can list them.
+		return c.nodes()[0].GetOAuthClientTokensContext(ctx, apiId, clientId)
+	}
+
+	var oauthClientTokens []OAuthClientToken
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/tyk/oauth/clients/%s/%s/tokens", c.Host, apiId, clientId), nil)
+	if err != nil {
+		return oauthClientTokens, err
+	}
+	body, err := c.doRequest(req)
+	if err != nil {
+		return oauthClientTokens, err
+	}
+
+	// Without a page parameter the gateway answers with a plain list, but the
+	// spec allows the paginated form as well.
+	if bytes.HasPrefix(bytes.TrimSpace(body), []byte("{")) {
+		var paginated struct {
+			Tokens []OAuthClientToken `json:"Tokens"`
+		}
+		err = json.Unmarshal(body, &paginated)
+		if err != nil {
+			return nil, err
+		}
+		return paginated.Tokens, nil
+	}
+
+	err = json.Unmarshal(body, &oauthClientTokens)
+	if err != nil {
+		return nil, err
+	}
+	return oauthClientTokens, nil
+}
@@ -0,0 +1,157 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+func orgKeyUrl(host string, orgId string, resetQuota bool) string {
+	url := fmt.Sprintf("%s/tyk/org/keys/%s", host, orgId)
+	if resetQuota {
+		url += "?reset_quota=1"
+	}
+	return url
+}
+
+func (c *Client) CreateOrgKey(orgId string, key Key, resetQuota bool) (ApiModifyKeySuccess, error) {
+	return c.CreateOrgKeyContext(context.Background(), orgId, key, resetQuota)
+}
+
+func (c *Client) CreateOrgKeyContext(ctx context.Context, orgId string, key Key, resetQuota bool) (ApiModifyKeySuccess, error) {
+	if c.isCluster() {
+		return fanOutCreate(c,
+			func(node *Client) (ApiModifyKeySuccess, error) {
+				return node.CreateOrgKeyContext(ctx, orgId, key, resetQuota)
+			},
+			func(node *Client, first ApiModifyKeySuccess) (ApiModifyKeySuccess, error) {
+				return node.CreateOrgKeyContext(ctx, orgId, key, resetQuota)
+			},
+			func(node *Client, created ApiModifyKeySuccess) error { return node.DeleteOrgKeyContext(ctx, orgId) },
+		)
+	}
+
+	var apiModifyKeySuccess ApiModifyKeySuccess
+
+	rb, err := json.Marshal(key)
+	if err != nil {
+		return apiModifyKeySuccess, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", orgKeyUrl(c.Host, orgId, resetQuota), strings.NewReader(string(rb)))
+	if err != nil {
+		return apiModifyKeySuccess, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return apiModifyKeySuccess, err
+	}
+
+	err = json.Unmarshal(body, &apiModifyKeySuccess)
+	if err != nil {
+		return ApiModifyKeySuccess{}, err
+	}
+
+	return apiModifyKeySuccess, nil
+}
+
+func (c *Client) GetOrgKey(orgId string) (Key, error) {
+	return c.GetOrgKeyContext(context.Background(), orgId)
+}
+
+func (c *Client) GetOrgKeyContext(ctx context.Context, orgId string) (Key, error) {
+	if c.isCluster() {
+		return fanOutRead(c, func(node *Client) (Key, error) { return node.GetOrgKeyContext(ctx, orgId) })
+	}
+
+	var key Key
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/tyk/org/keys/%s?orgID=%s", c.Host, orgId, orgId), nil)
+	if err != nil {
+		return key, err
+	}
+	body, err := c.doRequest(req)
+	if err != nil {
+		return key, err
+	}
+
+	err = json.Unmarshal(body, &key)
+	if err != nil {
+		return key, err
+	}
+	return key, nil
+}
+
+func (c *Client) UpdateOrgKey(orgId string, key Key, resetQuota bool) (ApiModifyKeySuccess, error) {
+	return c.UpdateOrgKeyContext(context.Background(), orgId, key, resetQuota)
+}
+
+func (c *Client) UpdateOrgKeyContext(ctx context.Context, orgId string, key Key, resetQuota bool) (ApiModifyKeySuccess, error) {
+	if c.isCluster() {
+		return fanOutUpdate(c,
+			func(node *Client) (Key, error) { return node.GetOrgKeyContext(ctx, orgId) },
+			func(node *Client) (ApiModifyKeySuccess, error) {
+				return node.UpdateOrgKeyContext(ctx, orgId, key, resetQuota)
+			},
+			func(node *Client, previous Key) error {
+				_, err := node.UpdateOrgKeyContext(ctx, orgId, previous, false)
+				return err
+			},
+		)
+	}
+
+	var apiModifyKeySuccess ApiModifyKeySuccess
+
+	rb, err := json.Marshal(key)
+	if err != nil {
+		return apiModifyKeySuccess, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "PUT", orgKeyUrl(c.Host, orgId, resetQuota), strings.NewReader(string(rb)))
+	if err != nil {
+		return apiModifyKeySuccess, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return apiModifyKeySuccess, err
+	}
+
+	err = json.Unmarshal(body, &apiModifyKeySuccess)
+	if err != nil {
+		return ApiModifyKeySuccess{}, err
+	}
+
+	return apiModifyKeySuccess, nil
+}
+
+func (c *Client) DeleteOrgKey(orgId string) error {
+	return c.DeleteOrgKeyContext(context.Background(), orgId)
+}
+
+func (c *Client) DeleteOrgKeyContext(ctx context.Context, orgId string) error {
+	if c.isCluster() {
+		return fanOutDelete(c,
+			func(node *Client) (Key, error) { return node.GetOrgKeyContext(ctx, orgId) },
+			func(node *Client) error { return node.DeleteOrgKeyContext(ctx, orgId) },
+			func(node *Client, previous Key) error {
+				_, err := node.CreateOrgKeyContext(ctx, orgId, previous, false)
+				return err
+			},
+		)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "DELETE", fmt.Sprintf("%s/tyk/org/keys/%s", c.Host, orgId), nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.doRequest(req)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
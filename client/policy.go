@@ -0,0 +1,203 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+type PolicyPartitions struct {
+	Quota      bool `json:"quota,omitempty" tfsdk:"quota"`
+	RateLimit  bool `json:"rate_limit,omitempty" tfsdk:"rate_limit"`
+	Complexity bool `json:"complexity,omitempty" tfsdk:"complexity"`
+	Acl        bool `json:"acl,omitempty" tfsdk:"acl"`
+	PerAPI     bool `json:"per_api,omitempty" tfsdk:"per_api"`
+}
+
+type Policy struct {
+	MID                           string                      `json:"_id,omitempty"`
+	ID                            string                      `json:"id,omitempty"`
+	Name                          string                      `json:"name,omitempty"`
+	OrgID                         string                      `json:"org_id,omitempty"`
+	Rate                          float64                     `json:"rate,omitempty"`
+	Per                           float64                     `json:"per,omitempty"`
+	QuotaMax                      int64                       `json:"quota_max,omitempty"`
+	QuotaRenewalRate              int64                       `json:"quota_renewal_rate,omitempty"`
+	ThrottleInterval              float64                     `json:"throttle_interval,omitempty"`
+	ThrottleRetryLimit            int                         `json:"throttle_retry_limit,omitempty"`
+	MaxQueryDepth                 int                         `json:"max_query_depth,omitempty"`
+	Smoothing                     *RateLimitSmoothing         `json:"smoothing,omitempty"`
+	AccessRights                  map[string]AccessDefinition `json:"access_rights,omitempty"`
+	HMACEnabled                   bool                        `json:"hmac_enabled,omitempty"`
+	EnableHTTPSignatureValidation bool                        `json:"enable_http_signature_validation,omitempty"`
+	Active                        bool                        `json:"active,omitempty"`
+	IsInactive                    bool                        `json:"is_inactive,omitempty"`
+	Tags                          []string                    `json:"tags,omitempty"`
+	KeyExpiresIn                  int64                       `json:"key_expires_in,omitempty"`
+	Partitions                    PolicyPartitions            `json:"partitions,omitempty"`
+	LastUpdated                   string                      `json:"last_updated,omitempty"`
+	MetaData                      map[string]any              `json:"meta_data,omitempty"`
+	// GraphQLAccessRights are kept as is, the gateway spec does not describe
+	// them.
+	GraphQLAccessRights map[string]json.RawMessage `json:"graphql_access_rights,omitempty"`
+
+	// Unknown holds the fields of newer gateways.
+	Unknown UnknownFields `json:"-"`
+}
+
+func (p *Policy) UnmarshalJSON(data []byte) error {
+	type policy Policy
+	unknown, err := unmarshalWithUnknown(data, (*policy)(p))
+	p.Unknown = unknown
+	return err
+}
+
+func (p Policy) MarshalJSON() ([]byte, error) {
+	type policy Policy
+	return marshalWithUnknown(policy(p), p.Unknown)
+}
+
+func (c *Client) CreatePolicy(policy Policy) (ApiModifyKeySuccess, error) {
+	return c.CreatePolicyContext(context.Background(), policy)
+}
+
+func (c *Client) CreatePolicyContext(ctx context.Context, policy Policy) (ApiModifyKeySuccess, error) {
+	if c.isCluster() {
+		return fanOutCreate(c,
+			func(node *Client) (ApiModifyKeySuccess, error) { return node.CreatePolicyContext(ctx, policy) },
+			func(node *Client, first ApiModifyKeySuccess) (ApiModifyKeySuccess, error) {
+				// Every node gets the policy ID generated by the first one.
+				policy := policy
+				policy.ID = first.Key
+				return node.CreatePolicyContext(ctx, policy)
+			},
+			func(node *Client, created ApiModifyKeySuccess) error {
+				return node.DeletePolicyContext(ctx, created.Key)
+			},
+		)
+	}
+
+	var apiModifyKeySuccess ApiModifyKeySuccess
+
+	rb, err := json.Marshal(policy)
+	if err != nil {
+		return apiModifyKeySuccess, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/tyk/policies", c.Host), strings.NewReader(string(rb)))
+	if err != nil {
+		return apiModifyKeySuccess, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return apiModifyKeySuccess, err
+	}
+
+	err = json.Unmarshal(body, &apiModifyKeySuccess)
+	if err != nil {
+		return ApiModifyKeySuccess{}, err
+	}
+
+	return apiModifyKeySuccess, nil
+}
+
+func (c *Client) GetPolicy(policyId string) (Policy, error) {
+	return c.GetPolicyContext(context.Background(), policyId)
+}
+
+func (c *Client) GetPolicyContext(ctx context.Context, policyId string) (Policy, error) {
+	if c.isCluster() {
+		return fanOutRead(c, func(node *Client) (Policy, error) { return node.GetPolicyContext(ctx, policyId) })
+	}
+
+	var policy Policy
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/tyk/policies/%s", c.Host, policyId), nil)
+	if err != nil {
+		return policy, err
+	}
+	body, err := c.doRequest(req)
+	if err != nil {
+		return policy, err
+	}
+
+	err = json.Unmarshal(body, &policy)
+	if err != nil {
+		return policy, err
+	}
+	return policy, nil
+}
+
+func (c *Client) UpdatePolicy(policyId string, policy Policy) (ApiModifyKeySuccess, error) {
+	return c.UpdatePolicyContext(context.Background(), policyId, policy)
+}
+
+func (c *Client) UpdatePolicyContext(ctx context.Context, policyId string, policy Policy) (ApiModifyKeySuccess, error) {
+	if c.isCluster() {
+		return fanOutUpdate(c,
+			func(node *Client) (Policy, error) { return node.GetPolicyContext(ctx, policyId) },
+			func(node *Client) (ApiModifyKeySuccess, error) {
+				return node.UpdatePolicyContext(ctx, policyId, policy)
+			},
+			func(node *Client, previous Policy) error {
+				_, err := node.UpdatePolicyContext(ctx, policyId, previous)
+				return err
+			},
+		)
+	}
+
+	var apiModifyKeySuccess ApiModifyKeySuccess
+
+	rb, err := json.Marshal(policy)
+	if err != nil {
+		return apiModifyKeySuccess, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "PUT", fmt.Sprintf("%s/tyk/policies/%s", c.Host, policyId), strings.NewReader(string(rb)))
+	if err != nil {
+		return apiModifyKeySuccess, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return apiModifyKeySuccess, err
+	}
+
+	err = json.Unmarshal(body, &apiModifyKeySuccess)
+	if err != nil {
+		return ApiModifyKeySuccess{}, err
+	}
+
+	return apiModifyKeySuccess, nil
+}
+
+func (c *Client) DeletePolicy(policyId string) error {
+	return c.DeletePolicyContext(context.Background(), policyId)
+}
+
+func (c *Client) DeletePolicyContext(ctx context.Context, policyId string) error {
+	if c.isCluster() {
+		return fanOutDelete(c,
+			func(node *Client) (Policy, error) { return node.GetPolicyContext(ctx, policyId) },
+			func(node *Client) error { return node.DeletePolicyContext(ctx, policyId) },
+			func(node *Client, previous Policy) error {
+				_, err := node.CreatePolicyContext(ctx, previous)
+				return err
+			},
+		)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "DELETE", fmt.Sprintf("%s/tyk/policies/%s", c.Host, policyId), nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.doRequest(req)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
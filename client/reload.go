@@ -0,0 +1,158 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// ReloadMode controls when the gateways are hot reloaded after changes to APIs
+// and policies, which only take effect once the gateways have reloaded.
+type ReloadMode string
+
+const (
+	// ReloadOff never reloads the gateways.
+	ReloadOff ReloadMode = "off"
+	// ReloadPerChange reloads the gateways once no further change has been
+	// recorded for the debounce period.
+	ReloadPerChange ReloadMode = "per_change"
+)
+
+const DefaultReloadDebounce = 1 * time.Second
+
+// ReloadModes are all valid reload modes.
+var ReloadModes = []ReloadMode{ReloadOff, ReloadPerChange}
+
+func (c *Client) ReloadGroup() (ApiStatusMessage, error) {
+	return c.ReloadGroupContext(context.Background())
+}
+
+// ReloadGroupContext asks the gateway to reload every gateway of its group.
+// The gateway answers before the reload has finished.
+func (c *Client) ReloadGroupContext(ctx context.Context) (ApiStatusMessage, error) {
+	if c.isCluster() {
+		return fanOutAll(c, func(node *Client) (ApiStatusMessage, error) { return node.ReloadGroupContext(ctx) })
+	}
+
+	return c.reload(ctx, fmt.Sprintf("%s/tyk/reload/group", c.Host))
+}
+
+func (c *Client) Reload(block bool) (ApiStatusMessage, error) {
+	return c.ReloadContext(context.Background(), block)
+}
+
+// ReloadContext reloads the gateway itself. With block, the gateway answers
+// once the reload has finished.
+func (c *Client) ReloadContext(ctx context.Context, block bool) (ApiStatusMessage, error) {
+	if c.isCluster() {
+		return fanOutAll(c, func(node *Client) (ApiStatusMessage, error) { return node.ReloadContext(ctx, block) })
+	}
+
+	return c.reload(ctx, fmt.Sprintf("%s/tyk/reload?block=%t", c.Host, block))
+}
+
+func (c *Client) reload(ctx context.Context, url string) (ApiStatusMessage, error) {
+	var apiStatusMessage ApiStatusMessage
+
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return apiStatusMessage, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return apiStatusMessage, err
+	}
+
+	err = json.Unmarshal(body, &apiStatusMessage)
+	if err != nil {
+		return ApiStatusMessage{}, err
+	}
+
+	return apiStatusMessage, nil
+}
+
+// Reloader debounces gateway reloads, so that many changes in a row cause a
+// single reload.
+type Reloader struct {
+	client *Client
+	mode   ReloadMode
+
+	// Wait makes a reload last until the gateways have finished reloading.
+	// A group reload returns right away, so the gateways of the client are
+	// reloaded once more with block=true.
+	Wait bool
+	// Debounce is the quiet period after a change before a per_change reload.
+	Debounce time.Duration
+
+	mu      sync.Mutex
+	timer   *time.Timer
+	pending *reloadBatch
+}
+
+// reloadBatch holds the changes that are applied by a single reload.
+type reloadBatch struct {
+	done chan struct{}
+	err  error
+}
+
+func NewReloader(c *Client, mode ReloadMode) *Reloader {
+	return &Reloader{
+		client:   c,
+		mode:     mode,
+		Debounce: DefaultReloadDebounce,
+	}
+}
+
+// Changed records a change that needs a reload and returns once the reload
+// has been sent. The reload is scheduled after the debounce period, so that
+// the changes Terraform applies concurrently share it. Changed must not return
+// before, since Terraform may stop the provider as soon as the last change is
+// reported, and the reload errors are reported along with the change. A nil
+// Reloader ignores changes.
+func (r *Reloader) Changed(ctx context.Context) error {
+	if r == nil || r.mode == ReloadOff {
+		return nil
+	}
+
+	r.mu.Lock()
+	if r.pending == nil {
+		r.pending = &reloadBatch{done: make(chan struct{})}
+	}
+	batch := r.pending
+	if r.timer != nil {
+		r.timer.Stop()
+	}
+	r.timer = time.AfterFunc(r.Debounce, func() { r.reload(batch) })
+	r.mu.Unlock()
+
+	select {
+	case <-batch.done:
+		return batch.err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// reload runs the reload for batch, unless another call already took it.
+func (r *Reloader) reload(batch *reloadBatch) {
+	r.mu.Lock()
+	if r.pending != batch {
+		r.mu.Unlock()
+		return
+	}
+	r.pending = nil
+	r.mu.Unlock()
+
+	// The reload is shared by all changes of the batch, so it must not be
+	// canceled along with the request of any one of them.
+	ctx := context.Background()
+	_, batch.err = r.client.ReloadGroupContext(ctx)
+	if batch.err == nil && r.Wait {
+		_, batch.err = r.client.ReloadContext(ctx, true)
+	}
+	close(batch.done)
+}
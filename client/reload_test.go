@@ -0,0 +1,147 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+)
+
+// reloadNode is a gateway that counts reloads.
+type reloadNode struct {
+	*httptest.Server
+
+	mu      sync.Mutex
+	group   int
+	blocked int
+}
+
+func newReloadNode(t *testing.T) *reloadNode {
+	node := &reloadNode{}
+	node.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		node.mu.Lock()
+		defer node.mu.Unlock()
+
+		switch {
+		case r.URL.Path == "/tyk/reload/group":
+			node.group++
+		case r.URL.Path == "/tyk/reload" && r.URL.Query().Get("block") == "true":
+			node.blocked++
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Write([]byte(`{"status":"ok","message":""}`))
+	}))
+	t.Cleanup(node.Close)
+
+	return node
+}
+
+func (node *reloadNode) reloads() (int, int) {
+	node.mu.Lock()
+	defer node.mu.Unlock()
+	return node.group, node.blocked
+}
+
+func TestReloaderPerChangeDebounces(t *testing.T) {
+	node := newReloadNode(t)
+	c, _ := NewClient(node.URL, "secret")
+	reloader := NewReloader(c, ReloadPerChange)
+	reloader.Debounce = 50 * time.Millisecond
+
+	var wg sync.WaitGroup
+	for range 50 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := reloader.Changed(context.Background()); err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	// Every change has returned, so its reload has been sent.
+	if group, blocked := node.reloads(); group != 1 || blocked != 0 {
+		t.Errorf("expected a single group reload, got %d group and %d blocking reloads", group, blocked)
+	}
+}
+
+func TestReloaderPerChangeWait(t *testing.T) {
+	node := newReloadNode(t)
+	c, _ := NewClient(node.URL, "secret")
+	reloader := NewReloader(c, ReloadPerChange)
+	reloader.Debounce = 10 * time.Millisecond
+	reloader.Wait = true
+
+	var wg sync.WaitGroup
+	for range 10 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := reloader.Changed(context.Background()); err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	// Every change has returned, so its reload has finished.
+	if group, blocked := node.reloads(); group != 1 || blocked != 1 {
+		t.Errorf("expected a single group and blocking reload, got %d group and %d blocking reloads", group, blocked)
+	}
+}
+
+func TestReloaderPerChangeError(t *testing.T) {
+	node := newReloadNode(t)
+	c, _ := NewClient(node.URL+"/missing", "secret")
+	c.MaxRetries = 0
+	reloader := NewReloader(c, ReloadPerChange)
+	reloader.Debounce = time.Millisecond
+
+	// The reload fails before Changed returns, so the error reaches the
+	// change that asked for it.
+	if err := reloader.Changed(context.Background()); err == nil {
+		t.Error("expected an error")
+	}
+}
+
+func TestReloaderOff(t *testing.T) {
+	node := newReloadNode(t)
+	c, _ := NewClient(node.URL, "secret")
+	reloader := NewReloader(c, ReloadOff)
+
+	if err := reloader.Changed(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if group, _ := node.reloads(); group != 0 {
+		t.Errorf("expected no reload, got %d", group)
+	}
+
+	var nilReloader *Reloader
+	if err := nilReloader.Changed(context.Background()); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestClusterReload(t *testing.T) {
+	first, second := newReloadNode(t), newReloadNode(t)
+	c, _ := NewClusterClient([]string{first.URL, second.URL}, "secret")
+
+	if _, err := c.ReloadGroup(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := c.Reload(true); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	for _, node := range []*reloadNode{first, second} {
+		if group, blocked := node.reloads(); group != 1 || blocked != 1 {
+			t.Errorf("node %s: expected a group and blocking reload, got %d and %d", node.URL, group, blocked)
+		}
+	}
+}
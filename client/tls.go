@@ -0,0 +1,52 @@
+package client
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"net/http"
+)
+
+// TLSConfig describes how the client verifies the gateway and authenticates
+// to it. Certificates and keys are PEM encoded.
+type TLSConfig struct {
+	CACert             string
+	ClientCert         string
+	ClientKey          string
+	ServerName         string
+	InsecureSkipVerify bool
+}
+
+// SetTLSConfig makes the client connect to the gateway with config.
+func (c *Client) SetTLSConfig(config TLSConfig) error {
+	tlsConfig := &tls.Config{
+		ServerName:         config.ServerName,
+		InsecureSkipVerify: config.InsecureSkipVerify,
+	}
+
+	if config.CACert != "" {
+		rootCAs := x509.NewCertPool()
+		if !rootCAs.AppendCertsFromPEM([]byte(config.CACert)) {
+			return errors.New("no certificates found in the CA certificate PEM")
+		}
+		tlsConfig.RootCAs = rootCAs
+	}
+
+	if config.ClientCert != "" || config.ClientKey != "" {
+		if config.ClientCert == "" || config.ClientKey == "" {
+			return errors.New("a client certificate and key must be set together")
+		}
+
+		certificate, err := tls.X509KeyPair([]byte(config.ClientCert), []byte(config.ClientKey))
+		if err != nil {
+			return err
+		}
+		tlsConfig.Certificates = []tls.Certificate{certificate}
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = tlsConfig
+	c.HTTPClient.Transport = transport
+
+	return nil
+}
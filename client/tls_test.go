@@ -0,0 +1,159 @@
+package client
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func newTLSTestServer(t *testing.T, tlsConfig *tls.Config) (*httptest.Server, string) {
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{}`))
+	}))
+	server.TLS = tlsConfig
+	server.StartTLS()
+	t.Cleanup(server.Close)
+
+	caCert := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+	return server, string(caCert)
+}
+
+func newTestCertificate(t *testing.T) (*x509.Certificate, string, string) {
+	privateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "terraform"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+		IsCA:         true,
+
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &privateKey.PublicKey, privateKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	certificate, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	keyDer, err := x509.MarshalECPrivateKey(privateKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	certPem := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	keyPem := pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer})
+	return certificate, string(certPem), string(keyPem)
+}
+
+func TestTLSCACert(t *testing.T) {
+	server, caCert := newTLSTestServer(t, nil)
+
+	c, _ := NewClient(server.URL, "secret")
+	c.MaxRetries = 0
+
+	if _, err := c.GetKey("key"); err == nil {
+		t.Fatal("expected the unknown CA to be rejected")
+	}
+
+	if err := c.SetTLSConfig(TLSConfig{CACert: caCert}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := c.GetKey("key"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestTLSServerName(t *testing.T) {
+	server, caCert := newTLSTestServer(t, nil)
+
+	c, _ := NewClient(server.URL, "secret")
+	c.MaxRetries = 0
+
+	// The test certificate is issued for example.com.
+	if err := c.SetTLSConfig(TLSConfig{CACert: caCert, ServerName: "example.com"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := c.GetKey("key"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if err := c.SetTLSConfig(TLSConfig{CACert: caCert, ServerName: "gateway.internal"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := c.GetKey("key"); err == nil {
+		t.Fatal("expected a server name mismatch to be rejected")
+	}
+}
+
+func TestTLSInsecureSkipVerify(t *testing.T) {
+	server, _ := newTLSTestServer(t, nil)
+
+	c, _ := NewClient(server.URL, "secret")
+	c.MaxRetries = 0
+
+	if err := c.SetTLSConfig(TLSConfig{InsecureSkipVerify: true}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := c.GetKey("key"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestTLSClientCertificate(t *testing.T) {
+	clientCertificate, clientCert, clientKey := newTestCertificate(t)
+
+	clientCAs := x509.NewCertPool()
+	clientCAs.AddCert(clientCertificate)
+	server, caCert := newTLSTestServer(t, &tls.Config{
+		ClientAuth: tls.RequireAndVerifyClientCert,
+		ClientCAs:  clientCAs,
+	})
+
+	c, _ := NewClient(server.URL, "secret")
+	c.MaxRetries = 0
+
+	if err := c.SetTLSConfig(TLSConfig{CACert: caCert}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := c.GetKey("key"); err == nil {
+		t.Fatal("expected the missing client certificate to be rejected")
+	}
+
+	if err := c.SetTLSConfig(TLSConfig{CACert: caCert, ClientCert: clientCert, ClientKey: clientKey}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := c.GetKey("key"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestTLSConfigErrors(t *testing.T) {
+	c, _ := NewClient("https://gateway.internal", "secret")
+
+	if err := c.SetTLSConfig(TLSConfig{CACert: "not a certificate"}); err == nil {
+		t.Error("expected an invalid CA certificate to be rejected")
+	}
+
+	_, clientCert, _ := newTestCertificate(t)
+	if err := c.SetTLSConfig(TLSConfig{ClientCert: clientCert}); err == nil {
+		t.Error("expected a client certificate without key to be rejected")
+	}
+}
@@ -0,0 +1,112 @@
+package client
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"sync"
+)
+
+// UnknownFields holds the JSON fields of a gateway object that its struct does
+// not model. They are written back as they were read, so that the fields of
+// newer gateways survive an update.
+type UnknownFields map[string]json.RawMessage
+
+// unmarshalWithUnknown decodes data into v, a pointer to a struct type without
+// an UnmarshalJSON method, and returns the fields v has no field for.
+func unmarshalWithUnknown(data []byte, v any) (UnknownFields, error) {
+	err := json.Unmarshal(data, v)
+	if err != nil {
+		return nil, err
+	}
+
+	var fields map[string]json.RawMessage
+	err = json.Unmarshal(data, &fields)
+	if err != nil {
+		return nil, err
+	}
+
+	known := jsonFieldNames(reflect.TypeOf(v).Elem())
+	var unknown UnknownFields
+	for name, value := range fields {
+		// Like encoding/json, match the field names case-insensitively.
+		if known[strings.ToLower(name)] {
+			continue
+		}
+		if unknown == nil {
+			unknown = UnknownFields{}
+		}
+		unknown[name] = value
+	}
+	return unknown, nil
+}
+
+// marshalWithUnknown encodes v, a struct without a MarshalJSON method, along
+// with the unknown fields. Unknown fields that match a field of v, with the
+// same case-insensitive rule as unmarshalWithUnknown, are left out, so that
+// the document does not hold a field twice.
+func marshalWithUnknown(v any, unknown UnknownFields) ([]byte, error) {
+	rb, err := json.Marshal(v)
+	if err != nil || len(unknown) == 0 {
+		return rb, err
+	}
+
+	var fields map[string]json.RawMessage
+	err = json.Unmarshal(rb, &fields)
+	if err != nil {
+		return nil, err
+	}
+	known := jsonFieldNames(reflect.TypeOf(v))
+	for name, value := range unknown {
+		if !known[strings.ToLower(name)] {
+			fields[name] = value
+		}
+	}
+	return json.Marshal(fields)
+}
+
+var jsonFieldNamesCache sync.Map
+
+// jsonFieldNames returns the lower cased JSON names of the fields of a struct
+// type, including those of embedded structs.
+func jsonFieldNames(t reflect.Type) map[string]bool {
+	if names, ok := jsonFieldNamesCache.Load(t); ok {
+		return names.(map[string]bool)
+	}
+
+	names := map[string]bool{}
+	for _, field := range reflect.VisibleFields(t) {
+		if !field.IsExported() || field.Anonymous && field.Tag.Get("json") == "" {
+			continue
+		}
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if name == "-" && !strings.HasPrefix(field.Tag.Get("json"), "-,") {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+		names[strings.ToLower(name)] = true
+	}
+
+	jsonFieldNamesCache.Store(t, names)
+	return names
+}
+
+// convertJSON converts between the typed and untyped forms of a gateway
+// object.
+func convertJSON[T any](from any) (T, error) {
+	var to T
+
+	rb, err := json.Marshal(from)
+	if err != nil {
+		return to, err
+	}
+
+	err = json.Unmarshal(rb, &to)
+	if err != nil {
+		var zero T
+		return zero, err
+	}
+	return to, nil
+}
@@ -0,0 +1,116 @@
+package client
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+// assertRoundTrip decodes document into T and checks that encoding it again
+// gives back the same document.
+func assertRoundTrip[T any](t *testing.T, document string) T {
+	t.Helper()
+
+	var typed T
+	if err := json.Unmarshal([]byte(document), &typed); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	rb, err := json.Marshal(typed)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var expected, got any
+	json.Unmarshal([]byte(document), &expected)
+	json.Unmarshal(rb, &got)
+	expectedJSON, _ := json.Marshal(expected)
+	gotJSON, _ := json.Marshal(got)
+	if string(gotJSON) != string(expectedJSON) {
+		t.Errorf("round trip changed the document\nexpected: %s\ngot:      %s", expectedJSON, gotJSON)
+	}
+	return typed
+}
+
+func TestUnknownFieldsRoundTrip(t *testing.T) {
+	session := assertRoundTrip[SessionState](t, `{
+		"org_id": "default",
+		"rate": 100,
+		"access_rights": {"api1": {"api_id": "api1", "versions": ["Default"], "limit": {"rate": 10}, "json_rpc_methods": ["ping"]}},
+		"post_expiry_action": "delete"
+	}`)
+	if session.OrgID != "default" || session.Rate != 100 {
+		t.Errorf("expected the known fields to be decoded, got %+v", session)
+	}
+	if string(session.Unknown["post_expiry_action"]) != `"delete"` {
+		t.Errorf("expected post_expiry_action to be kept, got %v", session.Unknown)
+	}
+	if _, ok := session.AccessRights["api1"].Unknown["json_rpc_methods"]; !ok {
+		t.Errorf("expected the unknown access rights fields to be kept")
+	}
+
+	api := assertRoundTrip[APIDefinition](t, `{
+		"api_id": "api1",
+		"proxy": {"listen_path": "/api1/", "target_url": "http://httpbin.org", "preserve_host_header_case": true},
+		"version_data": {"not_versioned": true, "versions": {"Default": {"name": "Default", "paths": {"ignored": []}, "mock_response": {}}}},
+		"CORS": {"enable": false},
+		"upstream_auth": {"enabled": true}
+	}`)
+	if api.Proxy == nil || api.Proxy.ListenPath != "/api1/" || api.VersionData.Versions["Default"].Name != "Default" {
+		t.Errorf("expected the nested objects to be decoded, got %+v", api)
+	}
+
+	assertRoundTrip[Policy](t, `{"id": "gold", "rate": 10, "partitions": {"quota": true}, "graphql_access_rights": {"api1": {"x": 1}}, "max_requests": 5}`)
+	assertRoundTrip[OAuthClientToken](t, `{"code": "abc", "expires": 1700000000, "scopes": ["read"]}`)
+	assertRoundTrip[HealthCheckResponse](t, `{"status": "pass", "details": {"redis": {"status": "pass", "componentType": "datastore"}}, "checks": 2}`)
+}
+
+func TestUnknownFieldsCase(t *testing.T) {
+	// encoding/json matches field names case-insensitively, so such fields are
+	// decoded instead of kept as unknown.
+	var token OAuthClientToken
+	if err := json.Unmarshal([]byte(`{"Code": "abc"}`), &token); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if token.Code != "abc" || len(token.Unknown) != 0 {
+		t.Errorf("expected Code to be decoded, got %+v", token)
+	}
+
+	// Encoding follows the same rule, so that an unknown field never shadows
+	// or duplicates a known one.
+	token.Unknown = UnknownFields{"CODE": json.RawMessage(`"xyz"`), "Scopes": json.RawMessage(`["read"]`)}
+	rb, err := json.Marshal(token)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got, expected := string(rb), `{"Scopes":["read"],"code":"abc","expires":0}`; got != expected {
+		t.Errorf("expected %s, got %s", expected, got)
+	}
+
+	var decoded OAuthClientToken
+	if err := json.Unmarshal(rb, &decoded); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if decoded.Code != "abc" || string(decoded.Unknown["Scopes"]) != `["read"]` || len(decoded.Unknown) != 1 {
+		t.Errorf("expected the round trip to keep the fields, got %+v", decoded)
+	}
+}
+
+func TestKeySessionStateConversion(t *testing.T) {
+	key := Key{"rate": 5.0, "per": 60.0, "post_expiry_action": "delete"}
+
+	session, err := key.SessionState()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if session.Rate != 5 {
+		t.Errorf("expected a rate of 5, got %v", session.Rate)
+	}
+
+	session.Rate = 10
+	key, err = session.Key()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if key["rate"] != 10.0 || key["post_expiry_action"] != "delete" {
+		t.Errorf("expected the updated rate and the unknown field, got %v", key)
+	}
+}
@@ -0,0 +1,41 @@
+terraform {
+  required_providers {
+    tykgateway = {
+      source = "github.com/thescenery/tykgateway"
+    }
+  }
+}
+
+provider "tykgateway" {
+  gateway_url   = "http://192.168.5.119/tyk-gateway"
+  api_key       = "foo"
+  hot_reload    = "per_change"
+  ready_timeout = "1m"
+}
+
+resource "tykgateway_api" "httpbin" {
+  wait_until_live = true
+  api_definition = jsonencode(
+    {
+      "name" : "Httpbin API",
+      "api_id" : "httpbin-api",
+      "org_id" : "default",
+      "use_keyless" : false,
+      "auth" : {
+        "auth_header_name" : "Authorization"
+      },
+      "proxy" : {
+        "listen_path" : "/httpbin/",
+        "target_url" : "http://httpbin.org",
+        "strip_listen_path" : true
+      },
+      "version_data" : {
+        "not_versioned" : true,
+        "versions" : {
+          "Default" : {
+            "name" : "Default"
+          }
+        }
+      }
+  })
+}
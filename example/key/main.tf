@@ -28,3 +28,24 @@ resource "tykgateway_key" "key1" {
       }
   })
 }
+
+resource "tykgateway_key" "key2" {
+  org_id    = "default"
+  rate      = 1000
+  per       = 1
+  quota_max = 10000
+
+  access_rights = {
+    "httpbin-api" = {
+      api_name = "Httpbin API"
+    }
+  }
+}
+
+data "tykgateway_key" "key2" {
+  key = tykgateway_key.key2.key
+}
+
+output "key2_quota_remaining" {
+  value = data.tykgateway_key.key2.quota_remaining
+}
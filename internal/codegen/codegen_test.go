@@ -0,0 +1,232 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"gopkg.in/yaml.v3"
+)
+
+func TestGeneratedFilesUpToDate(t *testing.T) {
+	spec, err := generateSpec("../../generator_config.yml", "../../gateway-swagger.yml")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	committed, err := os.ReadFile("../../provider_code_spec.json")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if string(spec) != string(committed) {
+		t.Errorf("provider_code_spec.json is out of date, run go generate ./...")
+	}
+
+	files, err := generateFramework("../../provider_code_spec.json", "generated")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	existing, _ := filepath.Glob(filepath.Join("../provider/generated", "*"+generatedSuffix))
+	if len(existing) != len(files) {
+		t.Errorf("expected %d generated files, found %d, run go generate ./...", len(files), len(existing))
+	}
+	for name, src := range files {
+		committed, err := os.ReadFile(filepath.Join("../provider/generated", name))
+		if err != nil || string(src) != string(committed) {
+			t.Errorf("%s is out of date, run go generate ./...", name)
+		}
+	}
+}
+
+const testOpenAPI = `
+components:
+  schemas:
+    Thing:
+      type: object
+      required: [name]
+      properties:
+        name:
+          type: string
+          description: The name.
+        secret:
+          type: string
+        legacyCount:
+          type: integer
+          deprecated: true
+        ratio:
+          type: number
+        tags:
+          type: array
+          items:
+            type: string
+        labels:
+          type: object
+          additionalProperties:
+            type: string
+        limit:
+          $ref: '#/components/schemas/Limit'
+        limits:
+          type: array
+          items:
+            $ref: '#/components/schemas/Limit'
+        limits_by_api:
+          type: object
+          additionalProperties:
+            $ref: '#/components/schemas/Limit'
+        matrix:
+          type: array
+          items:
+            type: array
+            items:
+              $ref: '#/components/schemas/Limit'
+        meta:
+          type: object
+          additionalProperties: {}
+    Limit:
+      type: object
+      description: A rate limit.
+      properties:
+        rate:
+          type: number
+        per:
+          type: number
+`
+
+func buildTestSpec(t *testing.T, config string) (Specification, error) {
+	t.Helper()
+
+	var document openapiDocument
+	if err := yaml.Unmarshal([]byte(testOpenAPI), &document); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var generatorConfig generatorConfig
+	if err := yaml.Unmarshal([]byte(config), &generatorConfig); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return buildSpec(generatorConfig, document)
+}
+
+func TestBuildSpec(t *testing.T) {
+	spec, err := buildTestSpec(t, `
+resources:
+  thing:
+    component: Thing
+    ignores: [meta]
+    overrides:
+      secret:
+        sensitive: true
+      limit.rate:
+        description: Requests per period.
+        computed_optional_required: computed_optional
+`)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	attributes := map[string]Attribute{}
+	for _, attribute := range spec.Resources[0].Schema.Attributes {
+		attributes[attribute.Name] = attribute
+	}
+
+	if len(attributes) != 10 {
+		t.Errorf("expected 10 attributes, got %d", len(attributes))
+	}
+	if name := attributes["name"].String; name == nil || name.ComputedOptionalRequired != "required" || name.Description != "The name." {
+		t.Errorf("expected a required name with its description, got %+v", name)
+	}
+	if secret := attributes["secret"].String; secret == nil || secret.Sensitive == nil || !*secret.Sensitive {
+		t.Errorf("expected a sensitive secret, got %+v", secret)
+	}
+	if count := attributes["legacy_count"].Int64; count == nil || count.DeprecationMessage == "" {
+		t.Errorf("expected a deprecated legacy_count, got %+v", count)
+	}
+	if attributes["ratio"].Float64 == nil {
+		t.Errorf("expected a float64 ratio")
+	}
+	if tags := attributes["tags"].List; tags == nil || tags.ElementType.String == nil {
+		t.Errorf("expected a list of strings for tags, got %+v", tags)
+	}
+	if labels := attributes["labels"].Map; labels == nil || labels.ElementType.String == nil {
+		t.Errorf("expected a map of strings for labels, got %+v", labels)
+	}
+
+	limit := attributes["limit"].SingleNested
+	if limit == nil || limit.Description != "A rate limit." || len(limit.Attributes) != 2 {
+		t.Fatalf("expected a nested limit, got %+v", limit)
+	}
+	if rate := limit.Attributes[1].Float64; limit.Attributes[1].Name != "rate" || rate.ComputedOptionalRequired != "computed_optional" || rate.Description != "Requests per period." {
+		t.Errorf("expected the overridden limit.rate, got %+v", limit.Attributes[1])
+	}
+
+	if limits := attributes["limits"].ListNested; limits == nil || len(limits.NestedObject.Attributes) != 2 {
+		t.Errorf("expected nested limits, got %+v", limits)
+	}
+	if limits := attributes["limits_by_api"].MapNested; limits == nil || len(limits.NestedObject.Attributes) != 2 {
+		t.Errorf("expected nested limits by API, got %+v", limits)
+	}
+	matrix := attributes["matrix"].List
+	if matrix == nil || matrix.ElementType.List == nil || matrix.ElementType.List.ElementType.Object == nil {
+		t.Errorf("expected a list of lists of objects for matrix, got %+v", matrix)
+	}
+}
+
+func TestBuildSpecErrors(t *testing.T) {
+	tests := map[string]struct {
+		config string
+		errors []string
+	}{
+		"free-form": {
+			config: `{resources: {thing: {component: Thing}}}`,
+			errors: []string{"resource thing: meta: free-form values are not supported"},
+		},
+		"unknown component": {
+			config: `{resources: {thing: {component: Things}}}`,
+			errors: []string{`unknown component "Things"`},
+		},
+		"unused": {
+			config: `{resources: {thing: {component: Thing, ignores: [meta, metadata], overrides: {limit.burst: {sensitive: true}}}}}`,
+			errors: []string{"no attributes match limit.burst, metadata"},
+		},
+		"invalid override": {
+			config: `{resources: {thing: {component: Thing, ignores: [meta], overrides: {name: {computed_optional_required: always}}}}}`,
+			errors: []string{`name: unknown computed_optional_required "always"`},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := buildTestSpec(t, test.config)
+			if err == nil {
+				t.Fatal("expected an error")
+			}
+			for _, expected := range test.errors {
+				if !strings.Contains(err.Error(), expected) {
+					t.Errorf("expected the error to contain %q, got %v", expected, err)
+				}
+			}
+		})
+	}
+}
+
+func TestNames(t *testing.T) {
+	for property, expected := range map[string]string{
+		"api_id":      "api_id",
+		"CORS":        "cors",
+		"listenPath":  "listen_path",
+		"x-tyk-proxy": "x_tyk_proxy",
+	} {
+		if got := attributeName(property); got != expected {
+			t.Errorf("attributeName(%q) = %q, expected %q", property, got, expected)
+		}
+	}
+
+	for name, expected := range map[string]string{
+		"api_id":                "ApiId",
+		"oauth_client":          "OauthClient",
+		"jwt_scope_to_policy_2": "JwtScopeToPolicy2",
+	} {
+		if got := goName(name); got != expected {
+			t.Errorf("goName(%q) = %q, expected %q", name, got, expected)
+		}
+	}
+}
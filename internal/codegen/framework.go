@@ -0,0 +1,273 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"go/format"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// generatedSuffix ends the name of every file the framework step writes.
+const generatedSuffix = "_resource_gen.go"
+
+func generateFramework(inputFile string, pkg string) (map[string][]byte, error) {
+	raw, err := os.ReadFile(inputFile)
+	if err != nil {
+		return nil, err
+	}
+
+	var spec Specification
+	if err := json.Unmarshal(raw, &spec); err != nil {
+		return nil, fmt.Errorf("%s: %w", inputFile, err)
+	}
+	if spec.Version != specVersion {
+		return nil, fmt.Errorf("%s: unsupported specification version %q", inputFile, spec.Version)
+	}
+
+	files := map[string][]byte{}
+	for _, resource := range spec.Resources {
+		src, err := generateResource(resource, pkg)
+		if err != nil {
+			return nil, fmt.Errorf("resource %s: %w", resource.Name, err)
+		}
+		files[resource.Name+generatedSuffix] = src
+	}
+
+	return files, nil
+}
+
+// resourceGenerator writes the schema function and models of a resource.
+type resourceGenerator struct {
+	usesAttr bool
+	models   strings.Builder
+	names    map[string]bool
+}
+
+func generateResource(resource Resource, pkg string) ([]byte, error) {
+	g := &resourceGenerator{names: map[string]bool{}}
+	name := goName(resource.Name)
+
+	attributes, err := g.attributes(resource.Schema.Attributes)
+	if err != nil {
+		return nil, err
+	}
+
+	var src strings.Builder
+	fmt.Fprintf(&src, "// Code generated by internal/codegen from provider_code_spec.json. DO NOT EDIT.\n\n")
+	fmt.Fprintf(&src, "package %s\n\n", pkg)
+	src.WriteString("import (\n\t\"context\"\n\n")
+	if g.usesAttr {
+		src.WriteString("\t\"github.com/hashicorp/terraform-plugin-framework/attr\"\n")
+	}
+	src.WriteString("\t\"github.com/hashicorp/terraform-plugin-framework/resource/schema\"\n")
+	src.WriteString("\t\"github.com/hashicorp/terraform-plugin-framework/types\"\n)\n\n")
+
+	fmt.Fprintf(&src, "// %sResourceSchema returns the %s resource schema.\n", name, resource.Name)
+	fmt.Fprintf(&src, "func %sResourceSchema(ctx context.Context) schema.Schema {\n", name)
+	fmt.Fprintf(&src, "return schema.Schema{\nAttributes: %s,\n}\n}\n", attributes)
+
+	doc := fmt.Sprintf("// %sModel is the model of the %s resource schema.", name, resource.Name)
+	err = g.model(name+"Model", doc, "", resource.Schema.Attributes)
+	if err != nil {
+		return nil, err
+	}
+	src.WriteString(g.models.String())
+
+	formatted, err := format.Source([]byte(src.String()))
+	if err != nil {
+		return nil, fmt.Errorf("formatting generated code: %w", err)
+	}
+	return formatted, nil
+}
+
+func (g *resourceGenerator) attributes(attributes []Attribute) (string, error) {
+	var src strings.Builder
+	src.WriteString("map[string]schema.Attribute{\n")
+	for _, attribute := range attributes {
+		code, err := g.attribute(attribute)
+		if err != nil {
+			return "", err
+		}
+		fmt.Fprintf(&src, "%q: %s,\n", attribute.Name, code)
+	}
+	src.WriteString("}")
+	return src.String(), nil
+}
+
+func (g *resourceGenerator) attribute(attribute Attribute) (string, error) {
+	var kind, fields string
+	switch {
+	case attribute.Bool != nil:
+		kind = "BoolAttribute"
+	case attribute.Float64 != nil:
+		kind = "Float64Attribute"
+	case attribute.Int64 != nil:
+		kind = "Int64Attribute"
+	case attribute.String != nil:
+		kind = "StringAttribute"
+	case attribute.List != nil:
+		kind = "ListAttribute"
+		fields = "ElementType: " + g.elementType(attribute.List.ElementType) + ",\n"
+	case attribute.Map != nil:
+		kind = "MapAttribute"
+		fields = "ElementType: " + g.elementType(attribute.Map.ElementType) + ",\n"
+	case attribute.ListNested != nil, attribute.MapNested != nil:
+		kind = "ListNestedAttribute"
+		nested := attribute.ListNested
+		if attribute.MapNested != nil {
+			kind = "MapNestedAttribute"
+			nested = attribute.MapNested
+		}
+		attributes, err := g.attributes(nested.NestedObject.Attributes)
+		if err != nil {
+			return "", err
+		}
+		fields = "NestedObject: schema.NestedAttributeObject{\nAttributes: " + attributes + ",\n},\n"
+	case attribute.SingleNested != nil:
+		kind = "SingleNestedAttribute"
+		attributes, err := g.attributes(attribute.SingleNested.Attributes)
+		if err != nil {
+			return "", err
+		}
+		fields = "Attributes: " + attributes + ",\n"
+	default:
+		return "", fmt.Errorf("attribute %s has no type", attribute.Name)
+	}
+
+	options := attribute.options()
+	switch options.ComputedOptionalRequired {
+	case "computed":
+		fields += "Computed: true,\n"
+	case "computed_optional":
+		fields += "Computed: true,\nOptional: true,\n"
+	case "optional":
+		fields += "Optional: true,\n"
+	case "required":
+		fields += "Required: true,\n"
+	default:
+		return "", fmt.Errorf("attribute %s: unknown computed_optional_required %q", attribute.Name, options.ComputedOptionalRequired)
+	}
+	if options.Sensitive != nil && *options.Sensitive {
+		fields += "Sensitive: true,\n"
+	}
+	if options.Description != "" {
+		fields += "Description: " + strconv.Quote(options.Description) + ",\n"
+	}
+	if options.DeprecationMessage != "" {
+		fields += "DeprecationMessage: " + strconv.Quote(options.DeprecationMessage) + ",\n"
+	}
+
+	return "schema." + kind + "{\n" + fields + "}", nil
+}
+
+func (g *resourceGenerator) elementType(element ElementType) string {
+	switch {
+	case element.Bool != nil:
+		return "types.BoolType"
+	case element.Float64 != nil:
+		return "types.Float64Type"
+	case element.Int64 != nil:
+		return "types.Int64Type"
+	case element.List != nil:
+		return "types.ListType{ElemType: " + g.elementType(element.List.ElementType) + "}"
+	case element.Map != nil:
+		return "types.MapType{ElemType: " + g.elementType(element.Map.ElementType) + "}"
+	case element.Object != nil:
+		g.usesAttr = true
+		var src strings.Builder
+		src.WriteString("types.ObjectType{AttrTypes: map[string]attr.Type{\n")
+		for _, attribute := range element.Object.AttributeTypes {
+			fmt.Fprintf(&src, "%q: %s,\n", attribute.Name, g.elementType(attribute.ElementType))
+		}
+		src.WriteString("}}")
+		return src.String()
+	}
+	return "types.StringType"
+}
+
+// model writes the struct for a list of attributes, followed by the structs
+// of its nested objects.
+func (g *resourceGenerator) model(name string, doc string, path string, attributes []Attribute) error {
+	if g.names[name] {
+		return fmt.Errorf("two models are named %s", name)
+	}
+	g.names[name] = true
+
+	type nestedModel struct {
+		name       string
+		doc        string
+		path       string
+		attributes []Attribute
+	}
+	var nested []nestedModel
+
+	fields := map[string]bool{}
+	fmt.Fprintf(&g.models, "\n%s\ntype %s struct {\n", doc, name)
+	for _, attribute := range attributes {
+		field := goName(attribute.Name)
+		if field == "" || fields[field] {
+			return fmt.Errorf("model %s: attribute %s has no unique field name", name, attribute.Name)
+		}
+		fields[field] = true
+
+		var fieldType string
+		var nestedAttributes []Attribute
+		switch {
+		case attribute.Bool != nil:
+			fieldType = "types.Bool"
+		case attribute.Float64 != nil:
+			fieldType = "types.Float64"
+		case attribute.Int64 != nil:
+			fieldType = "types.Int64"
+		case attribute.String != nil:
+			fieldType = "types.String"
+		case attribute.List != nil:
+			fieldType = "types.List"
+		case attribute.Map != nil:
+			fieldType = "types.Map"
+		case attribute.ListNested != nil:
+			fieldType = "types.List"
+			nestedAttributes = attribute.ListNested.NestedObject.Attributes
+		case attribute.MapNested != nil:
+			fieldType = "types.Map"
+			nestedAttributes = attribute.MapNested.NestedObject.Attributes
+		case attribute.SingleNested != nil:
+			fieldType = "types.Object"
+			nestedAttributes = attribute.SingleNested.Attributes
+		}
+		fmt.Fprintf(&g.models, "%s %s `tfsdk:%q`\n", field, fieldType, attribute.Name)
+
+		if nestedAttributes != nil {
+			nestedName := strings.TrimSuffix(name, "Model") + field + "Model"
+			nestedPath := joinPath(path, attribute.Name)
+			nested = append(nested, nestedModel{
+				name:       nestedName,
+				doc:        fmt.Sprintf("// %s is the model of the %s objects.", nestedName, nestedPath),
+				path:       nestedPath,
+				attributes: nestedAttributes,
+			})
+		}
+	}
+	g.models.WriteString("}\n")
+
+	for _, model := range nested {
+		if err := g.model(model.name, model.doc, model.path, model.attributes); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// goName converts a snake case name to an exported Go name, api_id to ApiId.
+func goName(name string) string {
+	var goName strings.Builder
+	for _, part := range strings.Split(name, "_") {
+		if part == "" {
+			continue
+		}
+		goName.WriteString(strings.ToUpper(part[:1]) + part[1:])
+	}
+	return goName.String()
+}
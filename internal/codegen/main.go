@@ -0,0 +1,102 @@
+// Command codegen generates the framework schemas and models in
+// internal/provider/generated from components of gateway-swagger.yml, in two
+// steps:
+//
+//	codegen spec       gateway-swagger.yml -> provider_code_spec.json
+//	codegen framework  provider_code_spec.json -> *_resource_gen.go
+//
+// Which components are generated, and the hand-written overrides applied to
+// them, live in generator_config.yml. The provider code spec follows the
+// Terraform Provider Code Specification, so tfplugingen-framework accepts it
+// too.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+)
+
+func main() {
+	log.SetFlags(0)
+	log.SetPrefix("codegen: ")
+
+	if len(os.Args) < 2 {
+		usage()
+	}
+
+	var err error
+	switch os.Args[1] {
+	case "spec":
+		err = runSpec(os.Args[2:])
+	case "framework":
+		err = runFramework(os.Args[2:])
+	default:
+		usage()
+	}
+	if err != nil {
+		log.Fatal(err)
+	}
+}
+
+func usage() {
+	fmt.Fprintln(os.Stderr, "usage: codegen spec|framework [flags]")
+	os.Exit(2)
+}
+
+func runSpec(args []string) error {
+	flags := flag.NewFlagSet("spec", flag.ExitOnError)
+	config := flags.String("config", "generator_config.yml", "generator config file")
+	input := flags.String("input", "gateway-swagger.yml", "gateway OpenAPI spec")
+	output := flags.String("output", "provider_code_spec.json", "provider code spec to write")
+	flags.Parse(args)
+
+	rb, err := generateSpec(*config, *input)
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(*output, rb, 0o644)
+}
+
+func runFramework(args []string) error {
+	flags := flag.NewFlagSet("framework", flag.ExitOnError)
+	input := flags.String("input", "provider_code_spec.json", "provider code spec")
+	output := flags.String("output", ".", "directory to write the Go files to")
+	pkg := flags.String("package", "", "package name, defaults to the output directory name")
+	flags.Parse(args)
+
+	if *pkg == "" {
+		dir, err := filepath.Abs(*output)
+		if err != nil {
+			return err
+		}
+		*pkg = filepath.Base(dir)
+	}
+
+	files, err := generateFramework(*input, *pkg)
+	if err != nil {
+		return err
+	}
+
+	// Remove the files of resources that are no longer generated.
+	stale, err := filepath.Glob(filepath.Join(*output, "*"+generatedSuffix))
+	if err != nil {
+		return err
+	}
+	for _, file := range stale {
+		if err := os.Remove(file); err != nil {
+			return err
+		}
+	}
+
+	for name, src := range files {
+		if err := os.WriteFile(filepath.Join(*output, name), src, 0o644); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,457 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+	"unicode"
+
+	"gopkg.in/yaml.v3"
+)
+
+// generatorConfig is the format of generator_config.yml.
+type generatorConfig struct {
+	Provider struct {
+		Name string `yaml:"name"`
+	} `yaml:"provider"`
+	Resources map[string]resourceConfig `yaml:"resources"`
+}
+
+type resourceConfig struct {
+	// Component is the name of the schema in components.schemas.
+	Component string `yaml:"component"`
+	// Ignores are the attribute paths left out of the schema.
+	Ignores []string `yaml:"ignores"`
+	// Overrides are keyed by attribute path.
+	Overrides map[string]attributeOverride `yaml:"overrides"`
+}
+
+type attributeOverride struct {
+	ComputedOptionalRequired string `yaml:"computed_optional_required"`
+	Description              string `yaml:"description"`
+	Sensitive                *bool  `yaml:"sensitive"`
+}
+
+type openapiDocument struct {
+	Components struct {
+		Schemas map[string]*openapiSchema `yaml:"schemas"`
+	} `yaml:"components"`
+}
+
+type openapiSchema struct {
+	Ref                  string                    `yaml:"$ref"`
+	Type                 string                    `yaml:"type"`
+	Description          string                    `yaml:"description"`
+	Deprecated           bool                      `yaml:"deprecated"`
+	Properties           map[string]*openapiSchema `yaml:"properties"`
+	Required             []string                  `yaml:"required"`
+	Items                *openapiSchema            `yaml:"items"`
+	AdditionalProperties *openapiSchema            `yaml:"additionalProperties"`
+	AllOf                []*openapiSchema          `yaml:"allOf"`
+
+	// closed is set for additionalProperties: false.
+	closed bool
+}
+
+func (s *openapiSchema) UnmarshalYAML(node *yaml.Node) error {
+	// additionalProperties may be a boolean instead of a schema.
+	if node.Kind == yaml.ScalarNode {
+		var allowed bool
+		if err := node.Decode(&allowed); err != nil {
+			return err
+		}
+		s.closed = !allowed
+		return nil
+	}
+
+	type plain openapiSchema
+	return node.Decode((*plain)(s))
+}
+
+var attributeNamePattern = regexp.MustCompile(`^[a-z_][a-z0-9_]*$`)
+
+func generateSpec(configFile string, inputFile string) ([]byte, error) {
+	var config generatorConfig
+	if err := decodeYAMLFile(configFile, &config, true); err != nil {
+		return nil, err
+	}
+
+	var document openapiDocument
+	if err := decodeYAMLFile(inputFile, &document, false); err != nil {
+		return nil, err
+	}
+
+	spec, err := buildSpec(config, document)
+	if err != nil {
+		return nil, err
+	}
+
+	rb, err := json.MarshalIndent(spec, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append(rb, '\n'), nil
+}
+
+func decodeYAMLFile(file string, v any, strict bool) error {
+	raw, err := os.ReadFile(file)
+	if err != nil {
+		return err
+	}
+
+	decoder := yaml.NewDecoder(bytes.NewReader(raw))
+	decoder.KnownFields(strict)
+	if err := decoder.Decode(v); err != nil {
+		return fmt.Errorf("%s: %w", file, err)
+	}
+	return nil
+}
+
+// buildSpec turns the configured components into resource schemas.
+func buildSpec(config generatorConfig, document openapiDocument) (Specification, error) {
+	spec := Specification{
+		Version:   specVersion,
+		Provider:  Provider{Name: config.Provider.Name},
+		Resources: []Resource{},
+	}
+
+	for _, name := range sortedKeys(config.Resources) {
+		resource := config.Resources[name]
+
+		component, ok := document.Components.Schemas[resource.Component]
+		if !ok {
+			return spec, fmt.Errorf("resource %s: unknown component %q", name, resource.Component)
+		}
+
+		builder := &specBuilder{
+			resource:  name,
+			schemas:   document.Components.Schemas,
+			ignores:   map[string]bool{},
+			overrides: map[string]attributeOverride{},
+		}
+		for _, path := range resource.Ignores {
+			builder.ignores[path] = false
+		}
+		for path, override := range resource.Overrides {
+			builder.overrides[path] = override
+		}
+
+		attributes, err := builder.attributes(builder.resolve(component), "")
+		if err != nil {
+			return spec, err
+		}
+		if len(builder.errs) > 0 {
+			return spec, errors.Join(builder.errs...)
+		}
+		if err := builder.checkUnused(); err != nil {
+			return spec, err
+		}
+
+		spec.Resources = append(spec.Resources, Resource{
+			Name:   name,
+			Schema: Schema{Attributes: attributes},
+		})
+	}
+
+	return spec, nil
+}
+
+// specBuilder converts the schemas of a single resource.
+type specBuilder struct {
+	resource string
+	schemas  map[string]*openapiSchema
+	// ignores records whether each ignore was used.
+	ignores   map[string]bool
+	overrides map[string]attributeOverride
+	errs      []error
+}
+
+// maxDepth bounds the nesting of attributes, which only recursive schemas
+// reach.
+const maxDepth = 16
+
+func (b *specBuilder) errorf(path string, format string, args ...any) error {
+	return fmt.Errorf("resource %s: %s: %s", b.resource, path, fmt.Sprintf(format, args...))
+}
+
+// resolve follows $ref and single schema allOf indirections.
+func (b *specBuilder) resolve(schema *openapiSchema) *openapiSchema {
+	for range maxDepth {
+		switch {
+		case len(schema.AllOf) == 1:
+			schema = schema.AllOf[0]
+		case schema.Ref != "":
+			target, ok := b.schemas[strings.TrimPrefix(schema.Ref, "#/components/schemas/")]
+			if !ok {
+				return &openapiSchema{}
+			}
+			schema = target
+		default:
+			return schema
+		}
+	}
+	return &openapiSchema{}
+}
+
+func (b *specBuilder) attributes(schema *openapiSchema, path string) ([]Attribute, error) {
+	if strings.Count(path, ".") >= maxDepth {
+		return nil, b.errorf(path, "attributes nested too deep, is the schema recursive?")
+	}
+
+	required := map[string]bool{}
+	for _, property := range schema.Required {
+		required[property] = true
+	}
+
+	attributes := []Attribute{}
+	seen := map[string]string{}
+	for _, property := range sortedKeys(schema.Properties) {
+		propertySchema := schema.Properties[property]
+		name := attributeName(property)
+		attributePath := joinPath(path, name)
+		if _, ok := b.ignores[attributePath]; ok {
+			b.ignores[attributePath] = true
+			continue
+		}
+		if !attributeNamePattern.MatchString(name) {
+			b.errs = append(b.errs, b.errorf(attributePath, "%q is not a valid attribute name, ignore the property", property))
+			continue
+		}
+		if other, ok := seen[name]; ok {
+			b.errs = append(b.errs, b.errorf(attributePath, "properties %q and %q have the same attribute name, ignore one of them", other, property))
+			continue
+		}
+		seen[name] = property
+
+		attribute, err := b.attribute(name, attributePath, propertySchema, required[property])
+		if err != nil {
+			// Carry on, to report every attribute that needs attention at once.
+			b.errs = append(b.errs, err)
+			continue
+		}
+		attributes = append(attributes, attribute)
+	}
+
+	sort.Slice(attributes, func(i, j int) bool {
+		return attributes[i].Name < attributes[j].Name
+	})
+
+	return attributes, nil
+}
+
+func (b *specBuilder) attribute(name string, path string, schema *openapiSchema, required bool) (Attribute, error) {
+	resolved := b.resolve(schema)
+	attribute := Attribute{Name: name}
+
+	options := AttributeOptions{
+		ComputedOptionalRequired: "optional",
+		Description:              strings.TrimSpace(schema.Description),
+	}
+	if required {
+		options.ComputedOptionalRequired = "required"
+	}
+	if options.Description == "" {
+		options.Description = strings.TrimSpace(resolved.Description)
+	}
+	if schema.Deprecated || resolved.Deprecated {
+		options.DeprecationMessage = "Deprecated by the Tyk Gateway API."
+	}
+
+	switch {
+	case resolved.Type == "boolean":
+		attribute.Bool = &PrimitiveAttribute{}
+	case resolved.Type == "number":
+		attribute.Float64 = &PrimitiveAttribute{}
+	case resolved.Type == "integer":
+		attribute.Int64 = &PrimitiveAttribute{}
+	case resolved.Type == "string":
+		attribute.String = &PrimitiveAttribute{}
+	case len(resolved.Properties) > 0:
+		attributes, err := b.attributes(resolved, path)
+		if err != nil {
+			return attribute, err
+		}
+		attribute.SingleNested = &SingleNestedAttribute{Attributes: attributes}
+	case resolved.Type == "array" && resolved.Items != nil:
+		nested, element, err := b.element(resolved.Items, path)
+		if err != nil {
+			return attribute, err
+		}
+		if nested != nil {
+			attribute.ListNested = &NestedAttribute{NestedObject: *nested}
+		} else {
+			attribute.List = &CollectionAttribute{ElementType: element}
+		}
+	case isMap(resolved):
+		nested, element, err := b.element(resolved.AdditionalProperties, path)
+		if err != nil {
+			return attribute, err
+		}
+		if nested != nil {
+			attribute.MapNested = &NestedAttribute{NestedObject: *nested}
+		} else {
+			attribute.Map = &CollectionAttribute{ElementType: element}
+		}
+	default:
+		return attribute, b.errorf(path, "free-form values are not supported, ignore the attribute")
+	}
+
+	*attribute.options() = options
+	if err := b.override(path, &attribute); err != nil {
+		return attribute, err
+	}
+
+	return attribute, nil
+}
+
+// element converts the items of a list or the values of a map. Objects become
+// nested attributes, anything else an element type.
+func (b *specBuilder) element(schema *openapiSchema, path string) (*NestedObject, ElementType, error) {
+	resolved := b.resolve(schema)
+	if len(resolved.Properties) > 0 {
+		attributes, err := b.attributes(resolved, path)
+		if err != nil {
+			return nil, ElementType{}, err
+		}
+		return &NestedObject{Attributes: attributes}, ElementType{}, nil
+	}
+
+	element, err := b.elementType(resolved, path)
+	return nil, element, err
+}
+
+func (b *specBuilder) elementType(schema *openapiSchema, path string) (ElementType, error) {
+	if strings.Count(path, ".") >= maxDepth {
+		return ElementType{}, b.errorf(path, "attributes nested too deep, is the schema recursive?")
+	}
+
+	resolved := b.resolve(schema)
+	switch {
+	case resolved.Type == "boolean":
+		return ElementType{Bool: &struct{}{}}, nil
+	case resolved.Type == "number":
+		return ElementType{Float64: &struct{}{}}, nil
+	case resolved.Type == "integer":
+		return ElementType{Int64: &struct{}{}}, nil
+	case resolved.Type == "string":
+		return ElementType{String: &struct{}{}}, nil
+	case len(resolved.Properties) > 0:
+		object := &ObjectType{AttributeTypes: []ObjectAttributeType{}}
+		for _, property := range sortedKeys(resolved.Properties) {
+			propertySchema := resolved.Properties[property]
+			name := attributeName(property)
+			attributePath := joinPath(path, name)
+			if _, ok := b.ignores[attributePath]; ok {
+				b.ignores[attributePath] = true
+				continue
+			}
+			element, err := b.elementType(propertySchema, attributePath)
+			if err != nil {
+				return ElementType{}, err
+			}
+			object.AttributeTypes = append(object.AttributeTypes, ObjectAttributeType{Name: name, ElementType: element})
+		}
+		sort.Slice(object.AttributeTypes, func(i, j int) bool {
+			return object.AttributeTypes[i].Name < object.AttributeTypes[j].Name
+		})
+		return ElementType{Object: object}, nil
+	case resolved.Type == "array" && resolved.Items != nil:
+		element, err := b.elementType(resolved.Items, path)
+		return ElementType{List: &CollectionType{ElementType: element}}, err
+	case isMap(resolved):
+		element, err := b.elementType(resolved.AdditionalProperties, path)
+		return ElementType{Map: &CollectionType{ElementType: element}}, err
+	}
+
+	return ElementType{}, b.errorf(path, "free-form values are not supported, ignore the attribute")
+}
+
+func (b *specBuilder) override(path string, attribute *Attribute) error {
+	override, ok := b.overrides[path]
+	if !ok {
+		return nil
+	}
+	delete(b.overrides, path)
+
+	options := attribute.options()
+	switch override.ComputedOptionalRequired {
+	case "":
+	case "computed", "computed_optional", "optional", "required":
+		options.ComputedOptionalRequired = override.ComputedOptionalRequired
+	default:
+		return b.errorf(path, "unknown computed_optional_required %q", override.ComputedOptionalRequired)
+	}
+	if override.Description != "" {
+		options.Description = override.Description
+	}
+	if override.Sensitive != nil {
+		options.Sensitive = override.Sensitive
+	}
+
+	return nil
+}
+
+// checkUnused reports ignores and overrides that match no attribute, which
+// happens when the spec changes under them.
+func (b *specBuilder) checkUnused() error {
+	var unused []string
+	for path, used := range b.ignores {
+		if !used {
+			unused = append(unused, path)
+		}
+	}
+	for path := range b.overrides {
+		unused = append(unused, path)
+	}
+	if len(unused) > 0 {
+		sort.Strings(unused)
+		return fmt.Errorf("resource %s: no attributes match %s", b.resource, strings.Join(unused, ", "))
+	}
+	return nil
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func isMap(schema *openapiSchema) bool {
+	return schema.Type == "object" && schema.AdditionalProperties != nil && !schema.AdditionalProperties.closed
+}
+
+func joinPath(path string, name string) string {
+	if path == "" {
+		return name
+	}
+	return path + "." + name
+}
+
+// attributeName converts a property name to snake case.
+func attributeName(property string) string {
+	var name strings.Builder
+	var previous rune
+	for _, r := range property {
+		switch {
+		case r == '-' || r == '.':
+			name.WriteByte('_')
+		case unicode.IsUpper(r):
+			if unicode.IsLower(previous) || unicode.IsDigit(previous) {
+				name.WriteByte('_')
+			}
+			name.WriteRune(unicode.ToLower(r))
+		default:
+			name.WriteRune(r)
+		}
+		previous = r
+	}
+	return name.String()
+}
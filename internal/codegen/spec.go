@@ -0,0 +1,118 @@
+package main
+
+// The types below are the subset of the Terraform Provider Code Specification
+// (version 0.1) that the generator produces.
+
+const specVersion = "0.1"
+
+type Specification struct {
+	Version   string     `json:"version"`
+	Provider  Provider   `json:"provider"`
+	Resources []Resource `json:"resources"`
+}
+
+type Provider struct {
+	Name string `json:"name"`
+}
+
+type Resource struct {
+	Name   string `json:"name"`
+	Schema Schema `json:"schema"`
+}
+
+type Schema struct {
+	Attributes []Attribute `json:"attributes"`
+}
+
+// Attribute has exactly one of its type fields set.
+type Attribute struct {
+	Name         string                 `json:"name"`
+	Bool         *PrimitiveAttribute    `json:"bool,omitempty"`
+	Float64      *PrimitiveAttribute    `json:"float64,omitempty"`
+	Int64        *PrimitiveAttribute    `json:"int64,omitempty"`
+	String       *PrimitiveAttribute    `json:"string,omitempty"`
+	List         *CollectionAttribute   `json:"list,omitempty"`
+	Map          *CollectionAttribute   `json:"map,omitempty"`
+	ListNested   *NestedAttribute       `json:"list_nested,omitempty"`
+	MapNested    *NestedAttribute       `json:"map_nested,omitempty"`
+	SingleNested *SingleNestedAttribute `json:"single_nested,omitempty"`
+}
+
+type AttributeOptions struct {
+	ComputedOptionalRequired string `json:"computed_optional_required"`
+	DeprecationMessage       string `json:"deprecation_message,omitempty"`
+	Description              string `json:"description,omitempty"`
+	Sensitive                *bool  `json:"sensitive,omitempty"`
+}
+
+type PrimitiveAttribute struct {
+	AttributeOptions
+}
+
+type CollectionAttribute struct {
+	AttributeOptions
+	ElementType ElementType `json:"element_type"`
+}
+
+type NestedAttribute struct {
+	AttributeOptions
+	NestedObject NestedObject `json:"nested_object"`
+}
+
+type NestedObject struct {
+	Attributes []Attribute `json:"attributes"`
+}
+
+type SingleNestedAttribute struct {
+	AttributeOptions
+	Attributes []Attribute `json:"attributes"`
+}
+
+// options returns the options of whichever type the attribute has.
+func (a *Attribute) options() *AttributeOptions {
+	switch {
+	case a.Bool != nil:
+		return &a.Bool.AttributeOptions
+	case a.Float64 != nil:
+		return &a.Float64.AttributeOptions
+	case a.Int64 != nil:
+		return &a.Int64.AttributeOptions
+	case a.String != nil:
+		return &a.String.AttributeOptions
+	case a.List != nil:
+		return &a.List.AttributeOptions
+	case a.Map != nil:
+		return &a.Map.AttributeOptions
+	case a.ListNested != nil:
+		return &a.ListNested.AttributeOptions
+	case a.MapNested != nil:
+		return &a.MapNested.AttributeOptions
+	case a.SingleNested != nil:
+		return &a.SingleNested.AttributeOptions
+	}
+	return nil
+}
+
+// ElementType has exactly one of its fields set.
+type ElementType struct {
+	Bool    *struct{}       `json:"bool,omitempty"`
+	Float64 *struct{}       `json:"float64,omitempty"`
+	Int64   *struct{}       `json:"int64,omitempty"`
+	String  *struct{}       `json:"string,omitempty"`
+	List    *CollectionType `json:"list,omitempty"`
+	Map     *CollectionType `json:"map,omitempty"`
+	Object  *ObjectType     `json:"object,omitempty"`
+}
+
+type CollectionType struct {
+	ElementType ElementType `json:"element_type"`
+}
+
+type ObjectType struct {
+	AttributeTypes []ObjectAttributeType `json:"attribute_types"`
+}
+
+type ObjectAttributeType struct {
+	Name string `json:"name"`
+	ElementType
+}
@@ -0,0 +1,765 @@
+// Package fakegateway is an in-memory stand-in for the Tyk Gateway API,
+// used to run the provider acceptance tests without a real gateway.
+package fakegateway
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+type Server struct {
+	*httptest.Server
+
+	secret string
+
+	mu           sync.Mutex
+	keys         map[string]map[string]any
+	keyHashes    map[string]string
+	apis         map[string]map[string]any
+	oasApis      map[string]map[string]any
+	policies     map[string]map[string]any
+	certs        map[string]*x509.Certificate
+	certKeys     map[string]bool
+	oauthClients map[string]map[string]any
+	orgKeys      map[string]map[string]any
+	reloads      int
+
+	// The gateway only serves the APIs and policies it loaded on the last
+	// reload, while writes go to the stored definitions.
+	loadedApis     map[string]map[string]any
+	loadedOasApis  map[string]map[string]any
+	loadedPolicies map[string]map[string]any
+}
+
+// New starts a fake gateway accepting secret as its admin secret. The caller
+// must call Close when done.
+func New(secret string) *Server {
+	s := &Server{
+		secret:       secret,
+		keys:         map[string]map[string]any{},
+		keyHashes:    map[string]string{},
+		apis:         map[string]map[string]any{},
+		oasApis:      map[string]map[string]any{},
+		policies:     map[string]map[string]any{},
+		certs:        map[string]*x509.Certificate{},
+		certKeys:     map[string]bool{},
+		oauthClients: map[string]map[string]any{},
+		orgKeys:      map[string]map[string]any{},
+
+		loadedApis:     map[string]map[string]any{},
+		loadedOasApis:  map[string]map[string]any{},
+		loadedPolicies: map[string]map[string]any{},
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /hello", s.hello)
+
+	mux.HandleFunc("POST /tyk/keys", s.createKey)
+	mux.HandleFunc("POST /tyk/keys/{keyID}", s.createKey)
+	mux.HandleFunc("GET /tyk/keys/{keyID}", s.getKey)
+	mux.HandleFunc("PUT /tyk/keys/{keyID}", s.updateKey)
+	mux.HandleFunc("DELETE /tyk/keys/{keyID}", s.deleteKey)
+
+	mux.HandleFunc("POST /tyk/apis", s.createApi)
+	mux.HandleFunc("GET /tyk/apis/{apiID}", s.getApi)
+	mux.HandleFunc("PUT /tyk/apis/{apiID}", s.updateApi)
+	mux.HandleFunc("DELETE /tyk/apis/{apiID}", s.deleteApi)
+
+	mux.HandleFunc("POST /tyk/apis/oas", s.createOasApi)
+	mux.HandleFunc("GET /tyk/apis/oas/{apiID}", s.getOasApi)
+	mux.HandleFunc("PUT /tyk/apis/oas/{apiID}", s.updateOasApi)
+	mux.HandleFunc("DELETE /tyk/apis/oas/{apiID}", s.deleteOasApi)
+
+	mux.HandleFunc("POST /tyk/policies", s.createPolicy)
+	mux.HandleFunc("GET /tyk/policies/{polID}", s.getPolicy)
+	mux.HandleFunc("PUT /tyk/policies/{polID}", s.updatePolicy)
+	mux.HandleFunc("DELETE /tyk/policies/{polID}", s.deletePolicy)
+
+	mux.HandleFunc("POST /tyk/certs", s.createCert)
+	mux.HandleFunc("GET /tyk/certs/{certID}", s.getCert)
+	mux.HandleFunc("DELETE /tyk/certs/{certID}", s.deleteCert)
+
+	mux.HandleFunc("POST /tyk/oauth/clients/create", s.createOAuthClient)
+	mux.HandleFunc("GET /tyk/oauth/clients/{apiID}/{keyName}", s.getOAuthClient)
+	mux.HandleFunc("PUT /tyk/oauth/clients/{apiID}/{keyName}", s.updateOAuthClient)
+	mux.HandleFunc("DELETE /tyk/oauth/clients/{apiID}/{keyName}", s.deleteOAuthClient)
+
+	mux.HandleFunc("POST /tyk/org/keys/{keyID}", s.createOrgKey)
+	mux.HandleFunc("GET /tyk/org/keys/{keyID}", s.getOrgKey)
+	mux.HandleFunc("PUT /tyk/org/keys/{keyID}", s.updateOrgKey)
+	mux.HandleFunc("DELETE /tyk/org/keys/{keyID}", s.deleteOrgKey)
+
+	mux.HandleFunc("GET /tyk/reload", s.reload)
+	mux.HandleFunc("GET /tyk/reload/group", s.reload)
+
+	s.Server = httptest.NewServer(s.authenticate(mux))
+	return s
+}
+
+// Reloads returns the number of reload requests received so far.
+func (s *Server) Reloads() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.reloads
+}
+
+// DeleteKey removes a key behind the provider's back.
+func (s *Server) DeleteKey(key string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.keys, key)
+}
+
+// UpdateKey changes a field of a key session behind the provider's back.
+func (s *Server) UpdateKey(key string, field string, value any) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if session, ok := s.keys[key]; ok {
+		session[field] = value
+	}
+}
+
+// KeyField returns a field of a key session.
+func (s *Server) KeyField(key string, field string) any {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.keys[key][field]
+}
+
+// UpdateOrgKey changes a field of an org key session behind the provider's
+// back.
+func (s *Server) UpdateOrgKey(orgId string, field string, value any) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if session, ok := s.orgKeys[orgId]; ok {
+		session[field] = value
+	}
+}
+
+// Reload loads the stored APIs and policies, like a reload the provider did
+// not ask for.
+func (s *Server) Reload() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.load()
+}
+
+func (s *Server) load() {
+	s.loadedApis = snapshot(s.apis)
+	s.loadedOasApis = snapshot(s.oasApis)
+	s.loadedPolicies = snapshot(s.policies)
+}
+
+// UpdateApi changes a field of a loaded API behind the provider's back.
+func (s *Server) UpdateApi(apiId string, field string, value any) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, apis := range []map[string]map[string]any{s.apis, s.loadedApis} {
+		if api, ok := apis[apiId]; ok {
+			api[field] = value
+		}
+	}
+}
+
+// UpdateOasApi changes a field of a loaded OAS API behind the provider's back.
+func (s *Server) UpdateOasApi(apiId string, field string, value any) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, apis := range []map[string]map[string]any{s.oasApis, s.loadedOasApis} {
+		if api, ok := apis[apiId]; ok {
+			api[field] = value
+		}
+	}
+}
+
+// UpdatePolicy changes a field of a loaded policy behind the provider's back.
+func (s *Server) UpdatePolicy(policyId string, field string, value any) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, policies := range []map[string]map[string]any{s.policies, s.loadedPolicies} {
+		if policy, ok := policies[policyId]; ok {
+			policy[field] = value
+		}
+	}
+}
+
+// DeletePolicy removes a stored and loaded policy behind the provider's back.
+func (s *Server) DeletePolicy(policyId string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.policies, policyId)
+	delete(s.loadedPolicies, policyId)
+}
+
+// PolicyField returns a field of a stored policy.
+func (s *Server) PolicyField(policyId string, field string) any {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.policies[policyId][field]
+}
+
+// UpdateOAuthClient changes a field of an OAuth client behind the provider's
+// back.
+func (s *Server) UpdateOAuthClient(apiId string, clientId string, field string, value any) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if oauthClient, ok := s.oauthClients[apiId+"/"+clientId]; ok {
+		oauthClient[field] = value
+	}
+}
+
+// HasPolicy reports whether the gateway stores the policy.
+func (s *Server) HasPolicy(policyId string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	_, ok := s.policies[policyId]
+	return ok
+}
+
+func (s *Server) authenticate(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/hello" && r.Header.Get("X-Tyk-Authorization") != s.secret {
+			writeStatus(w, http.StatusForbidden, "Attempted administrative access with invalid or missing key!")
+			return
+		}
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		next.ServeHTTP(w, r)
+	})
+}
+
+func (s *Server) hello(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, map[string]any{
+		"status":      "pass",
+		"version":     "v5.8.0",
+		"description": "Tyk GW",
+		"details":     map[string]any{},
+	})
+}
+
+func (s *Server) reload(w http.ResponseWriter, r *http.Request) {
+	s.reloads++
+	s.load()
+	writeJSON(w, http.StatusOK, map[string]any{"status": "ok", "message": ""})
+}
+
+func (s *Server) createKey(w http.ResponseWriter, r *http.Request) {
+	session, ok := readObject(w, r)
+	if !ok {
+		return
+	}
+
+	key := r.PathValue("keyID")
+	if key == "" {
+		key = randomId()
+	} else if _, exists := s.keys[key]; exists {
+		writeStatus(w, http.StatusBadRequest, "Key already exists")
+		return
+	}
+
+	keyHash := hashKey(key)
+	s.keys[key] = newSession(session)
+	s.keyHashes[keyHash] = key
+
+	response := map[string]any{"key": key, "status": "ok", "action": "added"}
+	if r.URL.Query().Get("hashed") == "true" {
+		response["key_hash"] = keyHash
+	}
+	writeJSON(w, http.StatusOK, response)
+}
+
+func (s *Server) lookupKey(r *http.Request) string {
+	keyId := r.PathValue("keyID")
+	if r.URL.Query().Get("hashed") == "true" {
+		return s.keyHashes[keyId]
+	}
+	return keyId
+}
+
+func (s *Server) getKey(w http.ResponseWriter, r *http.Request) {
+	session, ok := s.keys[s.lookupKey(r)]
+	if !ok {
+		writeStatus(w, http.StatusNotFound, "Key not found")
+		return
+	}
+	writeJSON(w, http.StatusOK, session)
+}
+
+func (s *Server) updateKey(w http.ResponseWriter, r *http.Request) {
+	key := s.lookupKey(r)
+	if _, ok := s.keys[key]; !ok {
+		writeStatus(w, http.StatusNotFound, "Key not found")
+		return
+	}
+
+	session, ok := readObject(w, r)
+	if !ok {
+		return
+	}
+	s.keys[key] = newSession(session)
+
+	writeJSON(w, http.StatusOK, map[string]any{"key": r.PathValue("keyID"), "status": "ok", "action": "modified"})
+}
+
+func (s *Server) deleteKey(w http.ResponseWriter, r *http.Request) {
+	key := s.lookupKey(r)
+	if _, ok := s.keys[key]; !ok {
+		writeStatus(w, http.StatusNotFound, "Key not found")
+		return
+	}
+	delete(s.keys, key)
+	delete(s.keyHashes, hashKey(key))
+
+	writeJSON(w, http.StatusOK, map[string]any{"key": r.PathValue("keyID"), "status": "ok", "action": "deleted"})
+}
+
+func (s *Server) createApi(w http.ResponseWriter, r *http.Request) {
+	api, ok := readObject(w, r)
+	if !ok {
+		return
+	}
+
+	apiId, _ := api["api_id"].(string)
+	if apiId == "" {
+		apiId = randomId()
+		api["api_id"] = apiId
+	}
+	s.apis[apiId] = api
+
+	writeJSON(w, http.StatusOK, map[string]any{"key": apiId, "status": "ok", "action": "added"})
+}
+
+func (s *Server) getApi(w http.ResponseWriter, r *http.Request) {
+	api, ok := s.loadedApis[r.PathValue("apiID")]
+	if !ok {
+		writeStatus(w, http.StatusNotFound, "API not found")
+		return
+	}
+	writeJSON(w, http.StatusOK, api)
+}
+
+func (s *Server) updateApi(w http.ResponseWriter, r *http.Request) {
+	apiId := r.PathValue("apiID")
+	if _, ok := s.apis[apiId]; !ok {
+		writeStatus(w, http.StatusNotFound, "API not found")
+		return
+	}
+
+	api, ok := readObject(w, r)
+	if !ok {
+		return
+	}
+	if api["api_id"] != apiId {
+		writeStatus(w, http.StatusBadRequest, "Request APIID does not match that in Definition! For Updtae operations these must match.")
+		return
+	}
+	s.apis[apiId] = api
+
+	writeJSON(w, http.StatusOK, map[string]any{"key": apiId, "status": "ok", "action": "modified"})
+}
+
+func (s *Server) deleteApi(w http.ResponseWriter, r *http.Request) {
+	apiId := r.PathValue("apiID")
+	if _, ok := s.apis[apiId]; !ok {
+		writeStatus(w, http.StatusNotFound, "API not found")
+		return
+	}
+	delete(s.apis, apiId)
+
+	writeJSON(w, http.StatusOK, map[string]any{"key": apiId, "status": "ok", "action": "deleted"})
+}
+
+func oasInfo(api map[string]any) (map[string]any, bool) {
+	extension, ok := api["x-tyk-api-gateway"].(map[string]any)
+	if !ok {
+		return nil, false
+	}
+	info, ok := extension["info"].(map[string]any)
+	if !ok {
+		info = map[string]any{}
+		extension["info"] = info
+	}
+	return info, true
+}
+
+func (s *Server) createOasApi(w http.ResponseWriter, r *http.Request) {
+	api, ok := readObject(w, r)
+	if !ok {
+		return
+	}
+
+	info, ok := oasInfo(api)
+	if !ok {
+		writeStatus(w, http.StatusBadRequest, "the payload should contain x-tyk-api-gateway")
+		return
+	}
+
+	apiId, _ := info["id"].(string)
+	if apiId == "" {
+		apiId = randomId()
+		info["id"] = apiId
+	}
+	s.oasApis[apiId] = api
+
+	writeJSON(w, http.StatusOK, map[string]any{"key": apiId, "status": "ok", "action": "added"})
+}
+
+func (s *Server) getOasApi(w http.ResponseWriter, r *http.Request) {
+	api, ok := s.loadedOasApis[r.PathValue("apiID")]
+	if !ok {
+		writeStatus(w, http.StatusNotFound, "API not found")
+		return
+	}
+	writeJSON(w, http.StatusOK, api)
+}
+
+func (s *Server) updateOasApi(w http.ResponseWriter, r *http.Request) {
+	apiId := r.PathValue("apiID")
+	if _, ok := s.oasApis[apiId]; !ok {
+		writeStatus(w, http.StatusNotFound, "API not found")
+		return
+	}
+
+	api, ok := readObject(w, r)
+	if !ok {
+		return
+	}
+	info, ok := oasInfo(api)
+	if !ok {
+		writeStatus(w, http.StatusBadRequest, "the payload should contain x-tyk-api-gateway")
+		return
+	}
+	if info["id"] != apiId {
+		writeStatus(w, http.StatusBadRequest, "Request APIID does not match that in Definition! For Update operations these must match.")
+		return
+	}
+	s.oasApis[apiId] = api
+
+	writeJSON(w, http.StatusOK, map[string]any{"key": apiId, "status": "ok", "action": "modified"})
+}
+
+func (s *Server) deleteOasApi(w http.ResponseWriter, r *http.Request) {
+	apiId := r.PathValue("apiID")
+	if _, ok := s.oasApis[apiId]; !ok {
+		writeStatus(w, http.StatusNotFound, "API not found")
+		return
+	}
+	delete(s.oasApis, apiId)
+
+	writeJSON(w, http.StatusOK, map[string]any{"key": apiId, "status": "ok", "action": "deleted"})
+}
+
+func (s *Server) createPolicy(w http.ResponseWriter, r *http.Request) {
+	policy, ok := readObject(w, r)
+	if !ok {
+		return
+	}
+
+	policyId, _ := policy["id"].(string)
+	if policyId == "" {
+		policyId = randomId()
+		policy["id"] = policyId
+	}
+	policy["last_updated"] = time.Now().Format(time.RFC3339)
+	s.policies[policyId] = policy
+
+	writeJSON(w, http.StatusOK, map[string]any{"key": policyId, "status": "ok", "action": "added"})
+}
+
+func (s *Server) getPolicy(w http.ResponseWriter, r *http.Request) {
+	policy, ok := s.loadedPolicies[r.PathValue("polID")]
+	if !ok {
+		writeStatus(w, http.StatusNotFound, "Policy not found")
+		return
+	}
+	writeJSON(w, http.StatusOK, policy)
+}
+
+func (s *Server) updatePolicy(w http.ResponseWriter, r *http.Request) {
+	policyId := r.PathValue("polID")
+	if _, ok := s.policies[policyId]; !ok {
+		writeStatus(w, http.StatusNotFound, "Policy not found")
+		return
+	}
+
+	policy, ok := readObject(w, r)
+	if !ok {
+		return
+	}
+	policy["id"] = policyId
+	policy["last_updated"] = time.Now().Format(time.RFC3339)
+	s.policies[policyId] = policy
+
+	writeJSON(w, http.StatusOK, map[string]any{"key": policyId, "status": "ok", "action": "modified"})
+}
+
+func (s *Server) deletePolicy(w http.ResponseWriter, r *http.Request) {
+	policyId := r.PathValue("polID")
+	if _, ok := s.policies[policyId]; !ok {
+		writeStatus(w, http.StatusNotFound, "Policy not found")
+		return
+	}
+	delete(s.policies, policyId)
+
+	writeJSON(w, http.StatusOK, map[string]any{"key": policyId, "status": "ok", "action": "deleted"})
+}
+
+func (s *Server) createCert(w http.ResponseWriter, r *http.Request) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		writeStatus(w, http.StatusMethodNotAllowed, "Malformed request body")
+		return
+	}
+
+	var certificate *x509.Certificate
+	hasPrivateKey := false
+	for rest := body; ; {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			break
+		}
+		switch {
+		case block.Type == "CERTIFICATE" && certificate == nil:
+			certificate, err = x509.ParseCertificate(block.Bytes)
+			if err != nil {
+				writeStatus(w, http.StatusMethodNotAllowed, "Malformed request body")
+				return
+			}
+		case strings.HasSuffix(block.Type, "PRIVATE KEY"):
+			hasPrivateKey = true
+		}
+	}
+	if certificate == nil {
+		writeStatus(w, http.StatusMethodNotAllowed, "Malformed request body")
+		return
+	}
+
+	fingerprint := sha256.Sum256(certificate.Raw)
+	certId := r.URL.Query().Get("org_id") + hex.EncodeToString(fingerprint[:])
+	if _, exists := s.certs[certId]; exists {
+		writeStatus(w, http.StatusForbidden, "Certificate with "+certId+" ID already exists.")
+		return
+	}
+	s.certs[certId] = certificate
+	s.certKeys[certId] = hasPrivateKey
+
+	writeJSON(w, http.StatusOK, map[string]any{"id": certId, "status": "ok", "message": "Certificate added"})
+}
+
+func (s *Server) getCert(w http.ResponseWriter, r *http.Request) {
+	certId := r.PathValue("certID")
+	certificate, ok := s.certs[certId]
+	if !ok {
+		writeStatus(w, http.StatusNotFound, "Certificate with given SHA256 fingerprint not found.")
+		return
+	}
+
+	fingerprint := sha256.Sum256(certificate.Raw)
+	writeJSON(w, http.StatusOK, map[string]any{
+		"id":          certId,
+		"fingerprint": hex.EncodeToString(fingerprint[:]),
+		"has_private": s.certKeys[certId],
+		"issuer":      certificate.Issuer,
+		"subject":     certificate.Subject,
+		"not_before":  certificate.NotBefore,
+		"not_after":   certificate.NotAfter,
+		"dns_names":   certificate.DNSNames,
+		"is_ca":       certificate.IsCA,
+	})
+}
+
+func (s *Server) deleteCert(w http.ResponseWriter, r *http.Request) {
+	certId := r.PathValue("certID")
+	if _, ok := s.certs[certId]; !ok {
+		writeStatus(w, http.StatusNotFound, "Certificate with given SHA256 fingerprint not found.")
+		return
+	}
+	delete(s.certs, certId)
+	delete(s.certKeys, certId)
+	writeStatus(w, http.StatusOK, "removed")
+}
+
+func (s *Server) createOAuthClient(w http.ResponseWriter, r *http.Request) {
+	oauthClient, ok := readObject(w, r)
+	if !ok {
+		return
+	}
+
+	apiId, _ := oauthClient["api_id"].(string)
+	api, ok := s.loadedApis[apiId]
+	if !ok || api["use_oauth2"] != true {
+		writeStatus(w, http.StatusBadRequest, "API doesn't exist")
+		return
+	}
+
+	clientId, _ := oauthClient["client_id"].(string)
+	if clientId == "" {
+		clientId = randomId()
+		oauthClient["client_id"] = clientId
+	}
+	if secret, _ := oauthClient["secret"].(string); secret == "" {
+		oauthClient["secret"] = randomId()
+	}
+	s.oauthClients[apiId+"/"+clientId] = oauthClient
+
+	writeJSON(w, http.StatusOK, oauthClient)
+}
+
+func (s *Server) getOAuthClient(w http.ResponseWriter, r *http.Request) {
+	oauthClient, ok := s.oauthClients[r.PathValue("apiID")+"/"+r.PathValue("keyName")]
+	if !ok {
+		writeStatus(w, http.StatusNotFound, "OAuth Client ID not found")
+		return
+	}
+	writeJSON(w, http.StatusOK, oauthClient)
+}
+
+func (s *Server) updateOAuthClient(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("apiID") + "/" + r.PathValue("keyName")
+	stored, ok := s.oauthClients[id]
+	if !ok {
+		writeStatus(w, http.StatusNotFound, "OAuth Client ID not found")
+		return
+	}
+
+	oauthClient, ok := readObject(w, r)
+	if !ok {
+		return
+	}
+	for _, field := range []string{"redirect_uri", "policy_id", "description", "meta_data"} {
+		stored[field] = oauthClient[field]
+	}
+
+	writeJSON(w, http.StatusOK, stored)
+}
+
+func (s *Server) deleteOAuthClient(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("apiID") + "/" + r.PathValue("keyName")
+	if _, ok := s.oauthClients[id]; !ok {
+		writeStatus(w, http.StatusNotFound, "OAuth Client ID not found")
+		return
+	}
+	delete(s.oauthClients, id)
+
+	writeJSON(w, http.StatusOK, map[string]any{"key": r.PathValue("keyName"), "status": "ok", "action": "deleted"})
+}
+
+func (s *Server) saveOrgKey(w http.ResponseWriter, r *http.Request, action string) {
+	session, ok := readObject(w, r)
+	if !ok {
+		return
+	}
+
+	orgId := r.PathValue("keyID")
+	stored := newSession(session)
+	if previous, ok := s.orgKeys[orgId]; ok && r.URL.Query().Get("reset_quota") != "1" {
+		stored["quota_remaining"] = previous["quota_remaining"]
+	}
+	s.orgKeys[orgId] = stored
+
+	writeJSON(w, http.StatusOK, map[string]any{"key": orgId, "status": "ok", "action": action})
+}
+
+func (s *Server) createOrgKey(w http.ResponseWriter, r *http.Request) {
+	s.saveOrgKey(w, r, "added")
+}
+
+func (s *Server) updateOrgKey(w http.ResponseWriter, r *http.Request) {
+	if _, ok := s.orgKeys[r.PathValue("keyID")]; !ok {
+		writeStatus(w, http.StatusNotFound, "Org not found")
+		return
+	}
+	s.saveOrgKey(w, r, "modified")
+}
+
+func (s *Server) getOrgKey(w http.ResponseWriter, r *http.Request) {
+	session, ok := s.orgKeys[r.PathValue("keyID")]
+	if !ok {
+		writeStatus(w, http.StatusNotFound, "Org not found")
+		return
+	}
+	writeJSON(w, http.StatusOK, session)
+}
+
+func (s *Server) deleteOrgKey(w http.ResponseWriter, r *http.Request) {
+	orgId := r.PathValue("keyID")
+	if _, ok := s.orgKeys[orgId]; !ok {
+		writeStatus(w, http.StatusNotFound, "Org not found")
+		return
+	}
+	delete(s.orgKeys, orgId)
+
+	writeJSON(w, http.StatusOK, map[string]any{"key": orgId, "status": "ok", "action": "deleted"})
+}
+
+// newSession fills in the fields the gateway manages on its own.
+func newSession(session map[string]any) map[string]any {
+	now := time.Now()
+	session["date_created"] = now.Format(time.RFC3339)
+	session["last_updated"] = strconv.FormatInt(now.Unix(), 10)
+	if quotaMax, ok := session["quota_max"].(float64); ok {
+		session["quota_remaining"] = quotaMax
+	} else {
+		session["quota_remaining"] = float64(0)
+	}
+	session["quota_renews"] = float64(now.Unix())
+	if _, ok := session["tags"]; !ok {
+		session["tags"] = nil
+	}
+	return session
+}
+
+// snapshot deep copies stored definitions, so that later writes do not change
+// what a reload loaded.
+func snapshot(objects map[string]map[string]any) map[string]map[string]any {
+	rb, _ := json.Marshal(objects)
+	var result map[string]map[string]any
+	json.Unmarshal(rb, &result)
+	return result
+}
+
+func readObject(w http.ResponseWriter, r *http.Request) (map[string]any, bool) {
+	var object map[string]any
+	if err := json.NewDecoder(r.Body).Decode(&object); err != nil || object == nil {
+		writeStatus(w, http.StatusBadRequest, "Request malformed")
+		return nil, false
+	}
+	return object, true
+}
+
+func writeStatus(w http.ResponseWriter, status int, message string) {
+	result := "ok"
+	if status >= http.StatusBadRequest {
+		result = "error"
+	}
+	writeJSON(w, status, map[string]any{"status": result, "message": message})
+}
+
+func writeJSON(w http.ResponseWriter, status int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(body)
+}
+
+func randomId() string {
+	b := make([]byte, 16)
+	rand.Read(b)
+	return hex.EncodeToString(b)
+}
+
+func hashKey(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:8])
+}
@@ -0,0 +1,477 @@
+package provider
+
+import (
+	"terraform-provider-tykgateway/client"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type accessDefinitionModel struct {
+	ApiId                types.String      `tfsdk:"api_id"`
+	ApiName              types.String      `tfsdk:"api_name"`
+	Versions             []types.String    `tfsdk:"versions"`
+	AllowedUrls          []accessSpecModel `tfsdk:"allowed_urls"`
+	Limit                *apiLimitModel    `tfsdk:"limit"`
+	DisableIntrospection types.Bool        `tfsdk:"disable_introspection"`
+	AllowanceScope       types.String      `tfsdk:"allowance_scope"`
+	Endpoints            []endpointModel   `tfsdk:"endpoints"`
+}
+
+type accessSpecModel struct {
+	Url     types.String   `tfsdk:"url"`
+	Methods []types.String `tfsdk:"methods"`
+}
+
+type endpointModel struct {
+	Path    types.String          `tfsdk:"path"`
+	Methods []endpointMethodModel `tfsdk:"methods"`
+}
+
+type endpointMethodModel struct {
+	Name  types.String    `tfsdk:"name"`
+	Limit *rateLimitModel `tfsdk:"limit"`
+}
+
+type rateLimitModel struct {
+	Rate types.Float64 `tfsdk:"rate"`
+	Per  types.Float64 `tfsdk:"per"`
+}
+
+type apiLimitModel struct {
+	Rate               types.Float64 `tfsdk:"rate"`
+	Per                types.Float64 `tfsdk:"per"`
+	ThrottleInterval   types.Float64 `tfsdk:"throttle_interval"`
+	ThrottleRetryLimit types.Int64   `tfsdk:"throttle_retry_limit"`
+	MaxQueryDepth      types.Int64   `tfsdk:"max_query_depth"`
+	QuotaMax           types.Int64   `tfsdk:"quota_max"`
+	QuotaRenewalRate   types.Int64   `tfsdk:"quota_renewal_rate"`
+}
+
+// accessRightsAttribute describes an access_rights map keyed by API ID, as
+// used by keys and policies.
+func accessRightsAttribute() schema.MapNestedAttribute {
+	return schema.MapNestedAttribute{
+		Description: "Access rights keyed by API ID.",
+		Optional:    true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"api_id": schema.StringAttribute{
+					Description: "The API ID. Defaults to the map key.",
+					Optional:    true,
+				},
+				"api_name": schema.StringAttribute{
+					Description: "The API name.",
+					Optional:    true,
+				},
+				"versions": schema.ListAttribute{
+					Description: "The API versions granted.",
+					ElementType: types.StringType,
+					Optional:    true,
+				},
+				"allowed_urls": schema.ListNestedAttribute{
+					Description: "URL paths and methods the access is restricted to.",
+					Optional:    true,
+					NestedObject: schema.NestedAttributeObject{
+						Attributes: map[string]schema.Attribute{
+							"url": schema.StringAttribute{
+								Description: "The URL path pattern.",
+								Required:    true,
+							},
+							"methods": schema.ListAttribute{
+								Description: "HTTP methods allowed for this URL.",
+								ElementType: types.StringType,
+								Optional:    true,
+							},
+						},
+					},
+				},
+				"limit": schema.SingleNestedAttribute{
+					Description: "Per-API rate limit and quota.",
+					Optional:    true,
+					Attributes: map[string]schema.Attribute{
+						"rate": schema.Float64Attribute{
+							Description: "The allowed number of requests per interval.",
+							Optional:    true,
+							Validators: []validator.Float64{
+								float64validator.AtLeast(0),
+							},
+						},
+						"per": schema.Float64Attribute{
+							Description: "The interval in seconds at which the rate limit is enforced.",
+							Optional:    true,
+							Validators: []validator.Float64{
+								float64validator.AtLeast(0),
+							},
+						},
+						"throttle_interval": schema.Float64Attribute{
+							Description: "The interval in seconds between throttled retries.",
+							Optional:    true,
+							Validators: []validator.Float64{
+								float64validator.AtLeast(0),
+							},
+						},
+						"throttle_retry_limit": schema.Int64Attribute{
+							Description: "The number of throttled retries.",
+							Optional:    true,
+							Validators: []validator.Int64{
+								int64validator.AtLeast(0),
+							},
+						},
+						"max_query_depth": schema.Int64Attribute{
+							Description: "The maximum GraphQL query depth.",
+							Optional:    true,
+						},
+						"quota_max": schema.Int64Attribute{
+							Description: "The maximum number of requests per quota period.",
+							Optional:    true,
+						},
+						"quota_renewal_rate": schema.Int64Attribute{
+							Description: "The quota period in seconds.",
+							Optional:    true,
+						},
+					},
+				},
+				"disable_introspection": schema.BoolAttribute{
+					Description: "Disables GraphQL introspection.",
+					Optional:    true,
+				},
+				"allowance_scope": schema.StringAttribute{
+					Description: "The allowance scope for per-endpoint or per-API limits.",
+					Optional:    true,
+				},
+				"endpoints": schema.ListNestedAttribute{
+					Description: "Per-endpoint rate limits.",
+					Optional:    true,
+					NestedObject: schema.NestedAttributeObject{
+						Attributes: map[string]schema.Attribute{
+							"path": schema.StringAttribute{
+								Description: "The endpoint path.",
+								Required:    true,
+							},
+							"methods": schema.ListNestedAttribute{
+								Description: "Rate limits by HTTP method.",
+								Required:    true,
+								NestedObject: schema.NestedAttributeObject{
+									Attributes: map[string]schema.Attribute{
+										"name": schema.StringAttribute{
+											Description: "The HTTP method.",
+											Required:    true,
+										},
+										"limit": schema.SingleNestedAttribute{
+											Description: "The rate limit for the method.",
+											Required:    true,
+											Attributes: map[string]schema.Attribute{
+												"rate": schema.Float64Attribute{
+													Description: "The allowed number of requests per interval.",
+													Required:    true,
+													Validators: []validator.Float64{
+														float64validator.AtLeast(0),
+													},
+												},
+												"per": schema.Float64Attribute{
+													Description: "The interval in seconds at which the rate limit is enforced.",
+													Required:    true,
+													Validators: []validator.Float64{
+														float64validator.AtLeast(0),
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// accessRightsToClient sets the access rights onto the live ones, so that the
+// fields of an access definition Terraform does not model are kept.
+func accessRightsToClient(accessRights map[string]accessDefinitionModel, live map[string]client.AccessDefinition) map[string]client.AccessDefinition {
+	if accessRights == nil {
+		return nil
+	}
+
+	result := make(map[string]client.AccessDefinition, len(accessRights))
+	for apiId, accessRight := range accessRights {
+		accessDefinition := live[apiId]
+		accessDefinition.APIID = apiId
+		accessDefinition.APIName = accessRight.ApiName.ValueString()
+		accessDefinition.Versions = stringsToClient(accessRight.Versions)
+		accessDefinition.DisableIntrospection = accessRight.DisableIntrospection.ValueBool()
+		accessDefinition.AllowanceScope = accessRight.AllowanceScope.ValueString()
+		accessDefinition.AllowedURLs = nil
+		accessDefinition.Endpoints = nil
+		if !accessRight.ApiId.IsNull() {
+			accessDefinition.APIID = accessRight.ApiId.ValueString()
+		}
+
+		for _, allowedUrl := range accessRight.AllowedUrls {
+			accessDefinition.AllowedURLs = append(accessDefinition.AllowedURLs, client.AccessSpec{
+				URL:     allowedUrl.Url.ValueString(),
+				Methods: stringsToClient(allowedUrl.Methods),
+			})
+		}
+
+		if accessRight.Limit != nil {
+			// The quota usage the gateway tracks is kept.
+			accessDefinition.Limit.Rate = accessRight.Limit.Rate.ValueFloat64()
+			accessDefinition.Limit.Per = accessRight.Limit.Per.ValueFloat64()
+			accessDefinition.Limit.ThrottleInterval = accessRight.Limit.ThrottleInterval.ValueFloat64()
+			accessDefinition.Limit.ThrottleRetryLimit = int(accessRight.Limit.ThrottleRetryLimit.ValueInt64())
+			accessDefinition.Limit.MaxQueryDepth = int(accessRight.Limit.MaxQueryDepth.ValueInt64())
+			accessDefinition.Limit.QuotaMax = accessRight.Limit.QuotaMax.ValueInt64()
+			accessDefinition.Limit.QuotaRenewalRate = accessRight.Limit.QuotaRenewalRate.ValueInt64()
+		} else {
+			accessDefinition.Limit = client.APILimit{}
+		}
+
+		for _, endpoint := range accessRight.Endpoints {
+			clientEndpoint := client.Endpoint{
+				Path: endpoint.Path.ValueString(),
+			}
+			for _, method := range endpoint.Methods {
+				clientMethod := client.EndpointMethod{
+					Name: method.Name.ValueString(),
+				}
+				if method.Limit != nil {
+					clientMethod.Limit = client.RateLimit{
+						Rate: method.Limit.Rate.ValueFloat64(),
+						Per:  method.Limit.Per.ValueFloat64(),
+					}
+				}
+				clientEndpoint.Methods = append(clientEndpoint.Methods, clientMethod)
+			}
+			accessDefinition.Endpoints = append(accessDefinition.Endpoints, clientEndpoint)
+		}
+
+		result[apiId] = accessDefinition
+	}
+
+	return result
+}
+
+func stringsToClient(values []types.String) []string {
+	if values == nil {
+		return nil
+	}
+
+	result := make([]string, 0, len(values))
+	for _, value := range values {
+		result = append(result, value.ValueString())
+	}
+	return result
+}
+
+// refreshAccessRights projects live access rights onto the configured ones.
+// Configured entries only refresh the attributes set in the configuration,
+// entries added in the gateway are taken whole.
+func refreshAccessRights(accessRights map[string]accessDefinitionModel, live map[string]client.AccessDefinition) map[string]accessDefinitionModel {
+	if accessRights == nil {
+		return nil
+	}
+
+	result := make(map[string]accessDefinitionModel, len(live))
+	for apiId, liveAccessRight := range live {
+		accessRight, ok := accessRights[apiId]
+		if !ok {
+			result[apiId] = accessDefinitionFromClient(liveAccessRight)
+			continue
+		}
+
+		refreshed := accessDefinitionModel{
+			ApiId:                refreshString(accessRight.ApiId, liveAccessRight.APIID),
+			ApiName:              refreshString(accessRight.ApiName, liveAccessRight.APIName),
+			Versions:             refreshStrings(accessRight.Versions, liveAccessRight.Versions),
+			DisableIntrospection: refreshBool(accessRight.DisableIntrospection, liveAccessRight.DisableIntrospection),
+			AllowanceScope:       refreshString(accessRight.AllowanceScope, liveAccessRight.AllowanceScope),
+		}
+		if accessRight.AllowedUrls != nil {
+			refreshed.AllowedUrls = accessSpecsFromClient(liveAccessRight.AllowedURLs)
+			if refreshed.AllowedUrls == nil {
+				refreshed.AllowedUrls = []accessSpecModel{}
+			}
+		}
+		if accessRight.Limit != nil {
+			refreshed.Limit = &apiLimitModel{
+				Rate:               refreshFloat64(accessRight.Limit.Rate, liveAccessRight.Limit.Rate),
+				Per:                refreshFloat64(accessRight.Limit.Per, liveAccessRight.Limit.Per),
+				ThrottleInterval:   refreshFloat64(accessRight.Limit.ThrottleInterval, liveAccessRight.Limit.ThrottleInterval),
+				ThrottleRetryLimit: refreshInt64(accessRight.Limit.ThrottleRetryLimit, int64(liveAccessRight.Limit.ThrottleRetryLimit)),
+				MaxQueryDepth:      refreshInt64(accessRight.Limit.MaxQueryDepth, int64(liveAccessRight.Limit.MaxQueryDepth)),
+				QuotaMax:           refreshInt64(accessRight.Limit.QuotaMax, liveAccessRight.Limit.QuotaMax),
+				QuotaRenewalRate:   refreshInt64(accessRight.Limit.QuotaRenewalRate, liveAccessRight.Limit.QuotaRenewalRate),
+			}
+		}
+		if accessRight.Endpoints != nil {
+			refreshed.Endpoints = endpointsFromClient(liveAccessRight.Endpoints)
+			if refreshed.Endpoints == nil {
+				refreshed.Endpoints = []endpointModel{}
+			}
+		}
+
+		result[apiId] = refreshed
+	}
+
+	return result
+}
+
+func accessDefinitionFromClient(accessDefinition client.AccessDefinition) accessDefinitionModel {
+	result := accessDefinitionModel{
+		ApiId:                stringFromClient(accessDefinition.APIID),
+		ApiName:              stringFromClient(accessDefinition.APIName),
+		Versions:             stringsFromClient(accessDefinition.Versions),
+		AllowedUrls:          accessSpecsFromClient(accessDefinition.AllowedURLs),
+		DisableIntrospection: boolFromClient(accessDefinition.DisableIntrospection),
+		AllowanceScope:       stringFromClient(accessDefinition.AllowanceScope),
+		Endpoints:            endpointsFromClient(accessDefinition.Endpoints),
+	}
+
+	limit := accessDefinition.Limit
+	if limit.Rate != 0 || limit.Per != 0 || limit.ThrottleInterval != 0 || limit.ThrottleRetryLimit != 0 ||
+		limit.MaxQueryDepth != 0 || limit.QuotaMax != 0 || limit.QuotaRenewalRate != 0 {
+		result.Limit = &apiLimitModel{
+			Rate:               float64FromClient(limit.Rate),
+			Per:                float64FromClient(limit.Per),
+			ThrottleInterval:   float64FromClient(limit.ThrottleInterval),
+			ThrottleRetryLimit: int64FromClient(int64(limit.ThrottleRetryLimit)),
+			MaxQueryDepth:      int64FromClient(int64(limit.MaxQueryDepth)),
+			QuotaMax:           int64FromClient(limit.QuotaMax),
+			QuotaRenewalRate:   int64FromClient(limit.QuotaRenewalRate),
+		}
+	}
+
+	return result
+}
+
+func accessSpecsFromClient(accessSpecs []client.AccessSpec) []accessSpecModel {
+	if len(accessSpecs) == 0 {
+		return nil
+	}
+
+	result := make([]accessSpecModel, 0, len(accessSpecs))
+	for _, accessSpec := range accessSpecs {
+		result = append(result, accessSpecModel{
+			Url:     types.StringValue(accessSpec.URL),
+			Methods: stringsFromClient(accessSpec.Methods),
+		})
+	}
+	return result
+}
+
+func endpointsFromClient(endpoints client.Endpoints) []endpointModel {
+	if len(endpoints) == 0 {
+		return nil
+	}
+
+	result := make([]endpointModel, 0, len(endpoints))
+	for _, endpoint := range endpoints {
+		methods := make([]endpointMethodModel, 0, len(endpoint.Methods))
+		for _, method := range endpoint.Methods {
+			methods = append(methods, endpointMethodModel{
+				Name: types.StringValue(method.Name),
+				Limit: &rateLimitModel{
+					Rate: types.Float64Value(method.Limit.Rate),
+					Per:  types.Float64Value(method.Limit.Per),
+				},
+			})
+		}
+		result = append(result, endpointModel{
+			Path:    types.StringValue(endpoint.Path),
+			Methods: methods,
+		})
+	}
+	return result
+}
+
+func stringsFromClient(values []string) []types.String {
+	if len(values) == 0 {
+		return nil
+	}
+
+	result := make([]types.String, 0, len(values))
+	for _, value := range values {
+		result = append(result, types.StringValue(value))
+	}
+	return result
+}
+
+// The gateway omits zero values, so they map to null attributes.
+
+func stringFromClient(value string) types.String {
+	if value == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(value)
+}
+
+func boolFromClient(value bool) types.Bool {
+	if !value {
+		return types.BoolNull()
+	}
+	return types.BoolValue(value)
+}
+
+func float64FromClient(value float64) types.Float64 {
+	if value == 0 {
+		return types.Float64Null()
+	}
+	return types.Float64Value(value)
+}
+
+func int64FromClient(value int64) types.Int64 {
+	if value == 0 {
+		return types.Int64Null()
+	}
+	return types.Int64Value(value)
+}
+
+// The refresh helpers leave attributes missing from the configuration null,
+// so values the gateway fills in on its own do not show up as changes.
+
+func refreshString(value types.String, live string) types.String {
+	if value.IsNull() {
+		return value
+	}
+	return types.StringValue(live)
+}
+
+func refreshBool(value types.Bool, live bool) types.Bool {
+	if value.IsNull() {
+		return value
+	}
+	return types.BoolValue(live)
+}
+
+func refreshFloat64(value types.Float64, live float64) types.Float64 {
+	if value.IsNull() {
+		return value
+	}
+	return types.Float64Value(live)
+}
+
+func refreshInt64(value types.Int64, live int64) types.Int64 {
+	if value.IsNull() {
+		return value
+	}
+	return types.Int64Value(live)
+}
+
+func refreshStrings(values []types.String, live []string) []types.String {
+	if values == nil {
+		return nil
+	}
+
+	result := make([]types.String, 0, len(live))
+	for _, value := range live {
+		result = append(result, types.StringValue(value))
+	}
+	return result
+}
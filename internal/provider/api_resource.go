@@ -2,22 +2,33 @@ package provider
 
 import (
 	"context"
+	"encoding/json"
+	"terraform-provider-tykgateway/client"
 
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
-var _ resource.Resource = (*apiResource)(nil)
+var _ resource.Resource = &apiResource{}
+var _ resource.ResourceWithConfigure = &apiResource{}
+var _ resource.ResourceWithImportState = &apiResource{}
 
 func NewApiResource() resource.Resource {
 	return &apiResource{}
 }
 
-type apiResource struct{}
+type apiResource struct {
+	client *client.Client
+}
 
 type apiResourceModel struct {
-	Id types.String `tfsdk:"id"`
+	ApiId         types.String    `tfsdk:"api_id"`
+	ApiDefinition jsonStringValue `tfsdk:"api_definition"`
+	WaitUntilLive types.Bool      `tfsdk:"wait_until_live"`
 }
 
 func (r *apiResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -26,14 +37,52 @@ func (r *apiResource) Metadata(ctx context.Context, req resource.MetadataRequest
 
 func (r *apiResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Description: "Manages a classic Tyk API definition.",
 		Attributes: map[string]schema.Attribute{
-			"id": schema.StringAttribute{
-				Computed: true,
+			"api_id": schema.StringAttribute{
+				Description: "The API ID assigned by the gateway.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"api_definition": schema.StringAttribute{
+				Description: "The classic API definition json string",
+				CustomType:  jsonStringType{},
+				Required:    true,
+			},
+			"wait_until_live": schema.BoolAttribute{
+				Description: "Waits after creating the API until the gateway has loaded it, for at most a minute. The gateway loads new APIs on a reload, see the provider hot_reload setting.",
+				Optional:    true,
 			},
 		},
 	}
 }
 
+func (r *apiResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			"Expected *client.Client, got something else.",
+		)
+		return
+	}
+
+	r.client = client
+
+	if r.client == nil {
+		resp.Diagnostics.AddError(
+			"Client Not Configured",
+			"The client is not configured, please check your provider configuration.",
+		)
+	}
+}
+
 func (r *apiResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data apiResourceModel
 
@@ -44,10 +93,44 @@ func (r *apiResource) Create(ctx context.Context, req resource.CreateRequest, re
 		return
 	}
 
+	var api map[string]any
+	err := json.Unmarshal([]byte(data.ApiDefinition.ValueString()), &api)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error parsing API definition JSON",
+			"Could not parse API definition JSON, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
 	// Create API call logic
+	createApiResponse, err := r.client.CreateApiContext(ctx, api)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating API",
+			clientErrorDetail("Could not create API", err),
+		)
+		return
+	}
 
-	// Example data value setting
-	data.Id = types.StringValue("example-id")
+	data.ApiId = types.StringValue(createApiResponse.Key)
+
+	// Reload the gateways for the change to take effect
+	reloadAfterChange(ctx, r.client, &resp.Diagnostics)
+
+	// Wait until the gateway serves the API. The state is saved either way,
+	// so that an API that never goes live is tainted instead of lost.
+	if data.WaitUntilLive.ValueBool() {
+		err = r.client.WaitForApiContext(ctx, data.ApiId.ValueString(), client.DefaultLiveTimeout)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error waiting for API",
+				clientErrorDetail("The API was created, but the gateway has not loaded it", err),
+			)
+		}
+	}
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -64,6 +147,37 @@ func (r *apiResource) Read(ctx context.Context, req resource.ReadRequest, resp *
 	}
 
 	// Read API call logic
+	liveApi, err := readLoaded(ctx, r.client,
+		func(ctx context.Context) (client.Api, error) {
+			return r.client.GetApiContext(ctx, data.ApiId.ValueString())
+		},
+		func(liveApi client.Api) bool {
+			apiDefinition, err := refreshJsonDocument(data.ApiDefinition, liveApi)
+			return err != nil || !jsonDocumentsEqual(apiDefinition, data.ApiDefinition)
+		},
+	)
+	if err != nil {
+		if client.IsNotFound(err) {
+			// The API was deleted outside of Terraform, recreate it on the next apply.
+			removeIfReloaded(ctx, r.client, &resp.State, &resp.Diagnostics, "API")
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error reading API",
+			clientErrorDetail("Could not read API", err),
+		)
+		return
+	}
+
+	apiDefinition, err := refreshJsonDocument(data.ApiDefinition, liveApi)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error parsing API definition JSON",
+			"Could not parse API definition JSON, unexpected error: "+err.Error(),
+		)
+		return
+	}
+	data.ApiDefinition = apiDefinition
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -79,7 +193,29 @@ func (r *apiResource) Update(ctx context.Context, req resource.UpdateRequest, re
 		return
 	}
 
+	var api map[string]any
+	err := json.Unmarshal([]byte(data.ApiDefinition.ValueString()), &api)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error parsing API definition JSON",
+			"Could not parse API definition JSON, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
 	// Update API call logic
+	_, err = r.client.UpdateApiContext(ctx, data.ApiId.ValueString(), api)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating API",
+			clientErrorDetail("Could not update API", err),
+		)
+		return
+	}
+
+	// Reload the gateways for the change to take effect
+	reloadAfterChange(ctx, r.client, &resp.Diagnostics)
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -96,4 +232,19 @@ func (r *apiResource) Delete(ctx context.Context, req resource.DeleteRequest, re
 	}
 
 	// Delete API call logic
+	err := r.client.DeleteApiContext(ctx, data.ApiId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting API",
+			clientErrorDetail("Could not delete API", err),
+		)
+		return
+	}
+
+	// Reload the gateways for the change to take effect
+	reloadAfterChange(ctx, r.client, &resp.Diagnostics)
+}
+
+func (r *apiResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("api_id"), req, resp)
 }
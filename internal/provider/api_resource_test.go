@@ -0,0 +1,132 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccApiResource(t *testing.T) {
+
+	t.Setenv("TF_ACC", "1")
+
+	var apiId string
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: hotReloadProviderConfig + testAccApiConfig("Httpbin API"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("tykgateway_api.api1", "api_id"),
+					func(s *terraform.State) error {
+						apiId = s.RootModule().Resources["tykgateway_api.api1"].Primary.Attributes["api_id"]
+						return nil
+					},
+				),
+			},
+			{
+				Config: hotReloadProviderConfig + testAccApiConfig("Renamed Httpbin API"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr("tykgateway_api.api1", "api_definition", regexp.MustCompile(`"name":"Renamed Httpbin API"`)),
+				),
+			},
+			{
+				// A change made outside of Terraform shows up as drift.
+				PreConfig:          func() { testAccGateway.UpdateApi(apiId, "name", "Changed API") },
+				Config:             hotReloadProviderConfig + testAccApiConfig("Renamed Httpbin API"),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: hotReloadProviderConfig + testAccApiConfig("Renamed Httpbin API"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr("tykgateway_api.api1", "api_definition", regexp.MustCompile(`"name":"Renamed Httpbin API"`)),
+				),
+			},
+			{
+				ResourceName:      "tykgateway_api.api1",
+				ImportState:       true,
+				ImportStateIdFunc: testAccImportStateId("tykgateway_api.api1", "api_id"),
+				ImportStateVerify: true,
+				// The imported api_definition carries every field of the live API.
+				ImportStateVerifyIdentifierAttribute: "api_id",
+				ImportStateVerifyIgnore:              []string{"api_definition"},
+			},
+		},
+	})
+}
+
+func testAccApiConfig(name string) string {
+	return fmt.Sprintf(`
+resource "tykgateway_api" "api1" {
+  api_definition = jsonencode(
+	{
+		"name": %q,
+		"org_id": "default",
+		"use_keyless": true,
+		"proxy": {
+			"listen_path": "/httpbin/",
+			"target_url": "http://httpbin.org",
+			"strip_listen_path": true
+		},
+		"version_data": {
+			"not_versioned": true,
+			"versions": {
+				"Default": {
+					"name": "Default"
+				}
+			}
+		}
+	})
+}`, name)
+}
+
+func TestAccApiResourceWaitUntilLive(t *testing.T) {
+
+	t.Setenv("TF_ACC", "1")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+provider "tykgateway" {
+  gateway_url   = "` + testAccGatewayUrl + `"
+  api_key       = "` + testAccApiKey + `"
+  hot_reload    = "per_change"
+  ready_timeout = "30s"
+}
+
+resource "tykgateway_api" "api1" {
+  wait_until_live = true
+  api_definition = jsonencode(
+	{
+		"name": "Live API",
+		"org_id": "default",
+		"use_keyless": true,
+		"proxy": {
+			"listen_path": "/live/",
+			"target_url": "http://httpbin.org",
+			"strip_listen_path": true
+		},
+		"version_data": {
+			"not_versioned": true,
+			"versions": {
+				"Default": {
+					"name": "Default"
+				}
+			}
+		}
+	})
+}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("tykgateway_api.api1", "api_id"),
+					resource.TestCheckResourceAttr("tykgateway_api.api1", "wait_until_live", "true"),
+				),
+			},
+		},
+	})
+}
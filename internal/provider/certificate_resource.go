@@ -0,0 +1,241 @@
+package provider
+
+import (
+	"context"
+	"terraform-provider-tykgateway/client"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &certificateResource{}
+var _ resource.ResourceWithConfigure = &certificateResource{}
+
+func NewCertificateResource() resource.Resource {
+	return &certificateResource{}
+}
+
+type certificateResource struct {
+	client *client.Client
+}
+
+type certificateResourceModel struct {
+	Certificate types.String `tfsdk:"certificate"`
+	OrgId       types.String `tfsdk:"org_id"`
+	CertId      types.String `tfsdk:"cert_id"`
+	Fingerprint types.String `tfsdk:"fingerprint"`
+	Subject     types.String `tfsdk:"subject"`
+	Issuer      types.String `tfsdk:"issuer"`
+	NotBefore   types.String `tfsdk:"not_before"`
+	NotAfter    types.String `tfsdk:"not_after"`
+	DnsNames    types.List   `tfsdk:"dns_names"`
+	HasPrivate  types.Bool   `tfsdk:"has_private"`
+	IsCa        types.Bool   `tfsdk:"is_ca"`
+}
+
+func (r *certificateResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_certificate"
+}
+
+func (r *certificateResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	// The gateway has no update endpoint for certificates, so every
+	// configurable attribute forces replacement and every computed one is
+	// stable for the lifetime of the resource.
+	computedString := func(description string) schema.StringAttribute {
+		return schema.StringAttribute{
+			Description: description,
+			Computed:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		}
+	}
+	computedBool := func(description string) schema.BoolAttribute {
+		return schema.BoolAttribute{
+			Description: description,
+			Computed:    true,
+			PlanModifiers: []planmodifier.Bool{
+				boolplanmodifier.UseStateForUnknown(),
+			},
+		}
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Manages a certificate in the Tyk Gateway certificate store.",
+		Attributes: map[string]schema.Attribute{
+			"certificate": schema.StringAttribute{
+				Description: "The PEM encoded certificate, optionally followed by its private key.",
+				Required:    true,
+				Sensitive:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"org_id": schema.StringAttribute{
+				Description: "The organisation the certificate belongs to.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"cert_id":     computedString("The certificate ID, the organisation ID followed by the SHA256 fingerprint."),
+			"fingerprint": computedString("The SHA256 fingerprint of the certificate."),
+			"subject":     computedString("The certificate subject."),
+			"issuer":      computedString("The certificate issuer."),
+			"not_before":  computedString("The start of the validity period, in RFC 3339 format."),
+			"not_after":   computedString("The end of the validity period, in RFC 3339 format."),
+			"dns_names": schema.ListAttribute{
+				Description: "The DNS names the certificate is valid for.",
+				ElementType: types.StringType,
+				Computed:    true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"has_private": computedBool("Indicates if a private key was uploaded with the certificate."),
+			"is_ca":       computedBool("Indicates if the certificate is a certificate authority."),
+		},
+	}
+}
+
+func (r *certificateResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			"Expected *client.Client, got something else.",
+		)
+		return
+	}
+
+	r.client = client
+
+	if r.client == nil {
+		resp.Diagnostics.AddError(
+			"Client Not Configured",
+			"The client is not configured, please check your provider configuration.",
+		)
+	}
+}
+
+func (r *certificateResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data certificateResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create API call logic
+	createCertificateResponse, err := r.client.CreateCertificateContext(ctx, data.Certificate.ValueString(), data.OrgId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating certificate",
+			clientErrorDetail("Could not create certificate", err),
+		)
+		return
+	}
+
+	certificateMeta, err := r.client.GetCertificateContext(ctx, createCertificateResponse.CertID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading certificate",
+			clientErrorDetail("Could not read certificate", err),
+		)
+		return
+	}
+	setCertificateMeta(&data, certificateMeta)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *certificateResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data certificateResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Read API call logic
+	certificateMeta, err := r.client.GetCertificateContext(ctx, data.CertId.ValueString())
+	if err != nil {
+		if client.IsNotFound(err) {
+			// The certificate was deleted outside of Terraform, recreate it on the next apply.
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error reading certificate",
+			clientErrorDetail("Could not read certificate", err),
+		)
+		return
+	}
+	setCertificateMeta(&data, certificateMeta)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *certificateResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Every configurable attribute requires replacement, so there is
+	// nothing to update in place.
+	resp.Diagnostics.AddError(
+		"Error updating certificate",
+		"Certificates cannot be updated in place, they must be replaced.",
+	)
+}
+
+func (r *certificateResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data certificateResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete API call logic
+	err := r.client.DeleteCertificateContext(ctx, data.CertId.ValueString(), data.OrgId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting certificate",
+			clientErrorDetail("Could not delete certificate", err),
+		)
+		return
+	}
+}
+
+func setCertificateMeta(data *certificateResourceModel, certificateMeta client.CertificateMeta) {
+	data.CertId = types.StringValue(certificateMeta.ID)
+	data.Fingerprint = types.StringValue(certificateMeta.Fingerprint)
+	data.Subject = types.StringValue(certificateMeta.Subject.String())
+	data.Issuer = types.StringValue(certificateMeta.Issuer.String())
+	data.NotBefore = types.StringValue(certificateMeta.NotBefore.Format(time.RFC3339))
+	data.NotAfter = types.StringValue(certificateMeta.NotAfter.Format(time.RFC3339))
+	data.HasPrivate = types.BoolValue(certificateMeta.HasPrivateKey)
+	data.IsCa = types.BoolValue(certificateMeta.IsCA)
+
+	dnsNames := make([]attr.Value, 0, len(certificateMeta.DNSNames))
+	for _, dnsName := range certificateMeta.DNSNames {
+		dnsNames = append(dnsNames, types.StringValue(dnsName))
+	}
+	data.DnsNames = types.ListValueMust(types.StringType, dnsNames)
+}
@@ -0,0 +1,66 @@
+package provider
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccCertificateResource(t *testing.T) {
+
+	t.Setenv("TF_ACC", "1")
+
+	certificate := testAccSelfSignedCertificate(t, "tyk.example.com")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + fmt.Sprintf(`
+resource "tykgateway_certificate" "cert1" {
+  certificate = <<EOT
+%sEOT
+}`, certificate),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("tykgateway_certificate.cert1", "cert_id"),
+					resource.TestCheckResourceAttrSet("tykgateway_certificate.cert1", "fingerprint"),
+					resource.TestCheckResourceAttr("tykgateway_certificate.cert1", "subject", "CN=tyk.example.com"),
+					resource.TestCheckResourceAttr("tykgateway_certificate.cert1", "dns_names.0", "tyk.example.com"),
+				),
+			},
+		},
+	})
+}
+
+func testAccSelfSignedCertificate(t *testing.T, commonName string) string {
+	t.Helper()
+
+	privateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	template := x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: commonName},
+		DNSNames:     []string{commonName},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(24 * time.Hour),
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, &template, &template, &privateKey.PublicKey, privateKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+}
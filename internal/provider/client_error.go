@@ -0,0 +1,29 @@
+package provider
+
+import (
+	"errors"
+	"fmt"
+	"terraform-provider-tykgateway/client"
+)
+
+// clientErrorDetail describes a failed gateway call, with a hint on how to
+// resolve it when the gateway reported the failure.
+func clientErrorDetail(action string, err error) string {
+	var apiError *client.APIError
+	if !errors.As(err, &apiError) {
+		return action + ", unexpected error: " + err.Error()
+	}
+
+	switch {
+	case client.IsBadRequest(err):
+		return action + ", the gateway rejected the request as invalid: " + apiError.Message
+	case client.IsUnauthorized(err):
+		return action + ", the gateway refused the API key. Check that the provider api_key matches the gateway secret."
+	case client.IsNotFound(err):
+		return action + ", the gateway could not find it: " + apiError.Message
+	case client.IsServerError(err):
+		return fmt.Sprintf("%s, the gateway failed with status %d: %s. Check the gateway logs, then retry.", action, apiError.StatusCode, apiError.Message)
+	default:
+		return action + ", unexpected error: " + err.Error()
+	}
+}
@@ -0,0 +1,3048 @@
+// Code generated by internal/codegen from provider_code_spec.json. DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// ApiDefinitionResourceSchema returns the api_definition resource schema.
+func ApiDefinitionResourceSchema(ctx context.Context) schema.Schema {
+	return schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"active": schema.BoolAttribute{
+				Optional: true,
+			},
+			"allowed_ips": schema.ListAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"analytics_plugin": schema.SingleNestedAttribute{
+				Attributes: map[string]schema.Attribute{
+					"enable": schema.BoolAttribute{
+						Optional: true,
+					},
+					"func_name": schema.StringAttribute{
+						Optional: true,
+					},
+					"plugin_path": schema.StringAttribute{
+						Optional: true,
+					},
+				},
+				Optional: true,
+			},
+			"api_id": schema.StringAttribute{
+				Optional: true,
+			},
+			"auth": schema.SingleNestedAttribute{
+				Attributes: map[string]schema.Attribute{
+					"auth_header_name": schema.StringAttribute{
+						Optional: true,
+					},
+					"cookie_name": schema.StringAttribute{
+						Optional: true,
+					},
+					"disable_header": schema.BoolAttribute{
+						Optional: true,
+					},
+					"name": schema.StringAttribute{
+						Optional: true,
+					},
+					"param_name": schema.StringAttribute{
+						Optional: true,
+					},
+					"signature": schema.SingleNestedAttribute{
+						Attributes: map[string]schema.Attribute{
+							"algorithm": schema.StringAttribute{
+								Optional: true,
+							},
+							"allowed_clock_skew": schema.Int64Attribute{
+								Optional: true,
+							},
+							"error_code": schema.Int64Attribute{
+								Optional: true,
+							},
+							"error_message": schema.StringAttribute{
+								Optional: true,
+							},
+							"header": schema.StringAttribute{
+								Optional: true,
+							},
+							"param_name": schema.StringAttribute{
+								Optional: true,
+							},
+							"secret": schema.StringAttribute{
+								Optional: true,
+							},
+							"use_param": schema.BoolAttribute{
+								Optional: true,
+							},
+						},
+						Optional: true,
+					},
+					"use_certificate": schema.BoolAttribute{
+						Optional: true,
+					},
+					"use_cookie": schema.BoolAttribute{
+						Optional: true,
+					},
+					"use_param": schema.BoolAttribute{
+						Optional: true,
+					},
+					"validate_signature": schema.BoolAttribute{
+						Optional: true,
+					},
+				},
+				Optional: true,
+			},
+			"auth_configs": schema.MapNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"auth_header_name": schema.StringAttribute{
+							Optional: true,
+						},
+						"cookie_name": schema.StringAttribute{
+							Optional: true,
+						},
+						"disable_header": schema.BoolAttribute{
+							Optional: true,
+						},
+						"name": schema.StringAttribute{
+							Optional: true,
+						},
+						"param_name": schema.StringAttribute{
+							Optional: true,
+						},
+						"signature": schema.SingleNestedAttribute{
+							Attributes: map[string]schema.Attribute{
+								"algorithm": schema.StringAttribute{
+									Optional: true,
+								},
+								"allowed_clock_skew": schema.Int64Attribute{
+									Optional: true,
+								},
+								"error_code": schema.Int64Attribute{
+									Optional: true,
+								},
+								"error_message": schema.StringAttribute{
+									Optional: true,
+								},
+								"header": schema.StringAttribute{
+									Optional: true,
+								},
+								"param_name": schema.StringAttribute{
+									Optional: true,
+								},
+								"secret": schema.StringAttribute{
+									Optional: true,
+								},
+								"use_param": schema.BoolAttribute{
+									Optional: true,
+								},
+							},
+							Optional: true,
+						},
+						"use_certificate": schema.BoolAttribute{
+							Optional: true,
+						},
+						"use_cookie": schema.BoolAttribute{
+							Optional: true,
+						},
+						"use_param": schema.BoolAttribute{
+							Optional: true,
+						},
+						"validate_signature": schema.BoolAttribute{
+							Optional: true,
+						},
+					},
+				},
+				Optional: true,
+			},
+			"auth_provider": schema.SingleNestedAttribute{
+				Attributes: map[string]schema.Attribute{
+					"name": schema.StringAttribute{
+						Optional: true,
+					},
+					"storage_engine": schema.StringAttribute{
+						Optional: true,
+					},
+				},
+				Optional: true,
+			},
+			"base_identity_provided_by": schema.StringAttribute{
+				Optional: true,
+			},
+			"basic_auth": schema.SingleNestedAttribute{
+				Attributes: map[string]schema.Attribute{
+					"body_password_regexp": schema.StringAttribute{
+						Optional: true,
+					},
+					"body_user_regexp": schema.StringAttribute{
+						Optional: true,
+					},
+					"cache_ttl": schema.Int64Attribute{
+						Optional: true,
+					},
+					"disable_caching": schema.BoolAttribute{
+						Optional: true,
+					},
+					"extract_from_body": schema.BoolAttribute{
+						Optional: true,
+					},
+				},
+				Optional: true,
+			},
+			"blacklisted_ips": schema.ListAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"cache_options": schema.SingleNestedAttribute{
+				Attributes: map[string]schema.Attribute{
+					"cache_all_safe_requests": schema.BoolAttribute{
+						Optional: true,
+					},
+					"cache_by_headers": schema.ListAttribute{
+						ElementType: types.StringType,
+						Optional:    true,
+					},
+					"cache_control_ttl_header": schema.StringAttribute{
+						Optional: true,
+					},
+					"cache_response_codes": schema.ListAttribute{
+						ElementType: types.Int64Type,
+						Optional:    true,
+					},
+					"cache_timeout": schema.Int64Attribute{
+						Optional: true,
+					},
+					"enable_cache": schema.BoolAttribute{
+						Optional: true,
+					},
+					"enable_upstream_cache_control": schema.BoolAttribute{
+						Optional: true,
+					},
+				},
+				Optional: true,
+			},
+			"certificate_pinning_disabled": schema.BoolAttribute{
+				Optional: true,
+			},
+			"certificates": schema.ListAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"client_certificates": schema.ListAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"config_data_disabled": schema.BoolAttribute{
+				Optional: true,
+			},
+			"cors": schema.SingleNestedAttribute{
+				Attributes: map[string]schema.Attribute{
+					"allow_credentials": schema.BoolAttribute{
+						Optional: true,
+					},
+					"allowed_headers": schema.ListAttribute{
+						ElementType: types.StringType,
+						Optional:    true,
+					},
+					"allowed_methods": schema.ListAttribute{
+						ElementType: types.StringType,
+						Optional:    true,
+					},
+					"allowed_origins": schema.ListAttribute{
+						ElementType: types.StringType,
+						Optional:    true,
+					},
+					"debug": schema.BoolAttribute{
+						Optional: true,
+					},
+					"enable": schema.BoolAttribute{
+						Optional: true,
+					},
+					"exposed_headers": schema.ListAttribute{
+						ElementType: types.StringType,
+						Optional:    true,
+					},
+					"max_age": schema.Int64Attribute{
+						Optional: true,
+					},
+					"options_passthrough": schema.BoolAttribute{
+						Optional: true,
+					},
+				},
+				Optional: true,
+			},
+			"custom_middleware": schema.SingleNestedAttribute{
+				Attributes: map[string]schema.Attribute{
+					"auth_check": schema.SingleNestedAttribute{
+						Attributes: map[string]schema.Attribute{
+							"disabled": schema.BoolAttribute{
+								Optional: true,
+							},
+							"name": schema.StringAttribute{
+								Optional: true,
+							},
+							"path": schema.StringAttribute{
+								Optional: true,
+							},
+							"raw_body_only": schema.BoolAttribute{
+								Optional: true,
+							},
+							"require_session": schema.BoolAttribute{
+								Optional: true,
+							},
+						},
+						Optional: true,
+					},
+					"driver": schema.StringAttribute{
+						Optional: true,
+					},
+					"id_extractor": schema.SingleNestedAttribute{
+						Attributes: map[string]schema.Attribute{
+							"disabled": schema.BoolAttribute{
+								Optional: true,
+							},
+							"extract_from": schema.StringAttribute{
+								Optional: true,
+							},
+							"extract_with": schema.StringAttribute{
+								Optional: true,
+							},
+						},
+						Optional: true,
+					},
+					"post": schema.ListNestedAttribute{
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"disabled": schema.BoolAttribute{
+									Optional: true,
+								},
+								"name": schema.StringAttribute{
+									Optional: true,
+								},
+								"path": schema.StringAttribute{
+									Optional: true,
+								},
+								"raw_body_only": schema.BoolAttribute{
+									Optional: true,
+								},
+								"require_session": schema.BoolAttribute{
+									Optional: true,
+								},
+							},
+						},
+						Optional: true,
+					},
+					"post_key_auth": schema.ListNestedAttribute{
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"disabled": schema.BoolAttribute{
+									Optional: true,
+								},
+								"name": schema.StringAttribute{
+									Optional: true,
+								},
+								"path": schema.StringAttribute{
+									Optional: true,
+								},
+								"raw_body_only": schema.BoolAttribute{
+									Optional: true,
+								},
+								"require_session": schema.BoolAttribute{
+									Optional: true,
+								},
+							},
+						},
+						Optional: true,
+					},
+					"pre": schema.ListNestedAttribute{
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"disabled": schema.BoolAttribute{
+									Optional: true,
+								},
+								"name": schema.StringAttribute{
+									Optional: true,
+								},
+								"path": schema.StringAttribute{
+									Optional: true,
+								},
+								"raw_body_only": schema.BoolAttribute{
+									Optional: true,
+								},
+								"require_session": schema.BoolAttribute{
+									Optional: true,
+								},
+							},
+						},
+						Optional: true,
+					},
+					"response": schema.ListNestedAttribute{
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"disabled": schema.BoolAttribute{
+									Optional: true,
+								},
+								"name": schema.StringAttribute{
+									Optional: true,
+								},
+								"path": schema.StringAttribute{
+									Optional: true,
+								},
+								"raw_body_only": schema.BoolAttribute{
+									Optional: true,
+								},
+								"require_session": schema.BoolAttribute{
+									Optional: true,
+								},
+							},
+						},
+						Optional: true,
+					},
+				},
+				Optional: true,
+			},
+			"custom_middleware_bundle": schema.StringAttribute{
+				Optional: true,
+			},
+			"custom_middleware_bundle_disabled": schema.BoolAttribute{
+				Optional: true,
+			},
+			"custom_plugin_auth_enabled": schema.BoolAttribute{
+				Optional: true,
+			},
+			"definition": schema.SingleNestedAttribute{
+				Attributes: map[string]schema.Attribute{
+					"default": schema.StringAttribute{
+						Optional: true,
+					},
+					"enabled": schema.BoolAttribute{
+						Optional: true,
+					},
+					"fallback_to_default": schema.BoolAttribute{
+						Optional: true,
+					},
+					"key": schema.StringAttribute{
+						Optional: true,
+					},
+					"location": schema.StringAttribute{
+						Optional: true,
+					},
+					"name": schema.StringAttribute{
+						Optional: true,
+					},
+					"strip_path": schema.BoolAttribute{
+						Optional: true,
+					},
+					"strip_versioning_data": schema.BoolAttribute{
+						Optional: true,
+					},
+					"url_versioning_pattern": schema.StringAttribute{
+						Optional: true,
+					},
+					"versions": schema.MapAttribute{
+						ElementType: types.StringType,
+						Optional:    true,
+					},
+				},
+				Optional: true,
+			},
+			"detailed_tracing": schema.BoolAttribute{
+				Optional: true,
+			},
+			"disable_quota": schema.BoolAttribute{
+				Optional: true,
+			},
+			"disable_rate_limit": schema.BoolAttribute{
+				Optional: true,
+			},
+			"do_not_track": schema.BoolAttribute{
+				Optional: true,
+			},
+			"domain": schema.StringAttribute{
+				Optional: true,
+			},
+			"domain_disabled": schema.BoolAttribute{
+				Optional: true,
+			},
+			"dont_set_quota_on_create": schema.BoolAttribute{
+				Optional: true,
+			},
+			"enable_batch_request_support": schema.BoolAttribute{
+				Optional: true,
+			},
+			"enable_context_vars": schema.BoolAttribute{
+				Optional: true,
+			},
+			"enable_coprocess_auth": schema.BoolAttribute{
+				Optional: true,
+			},
+			"enable_detailed_recording": schema.BoolAttribute{
+				Optional: true,
+			},
+			"enable_ip_blacklisting": schema.BoolAttribute{
+				Optional: true,
+			},
+			"enable_ip_whitelisting": schema.BoolAttribute{
+				Optional: true,
+			},
+			"enable_jwt": schema.BoolAttribute{
+				Optional: true,
+			},
+			"enable_proxy_protocol": schema.BoolAttribute{
+				Optional: true,
+			},
+			"enable_signature_checking": schema.BoolAttribute{
+				Optional: true,
+			},
+			"event_handlers": schema.SingleNestedAttribute{
+				Attributes: map[string]schema.Attribute{
+					"events": schema.MapAttribute{
+						ElementType: types.ListType{ElemType: types.ObjectType{AttrTypes: map[string]attr.Type{
+							"handler_name": types.StringType,
+						}}},
+						Optional: true,
+					},
+				},
+				Optional: true,
+			},
+			"expiration": schema.StringAttribute{
+				Optional: true,
+			},
+			"expire_analytics_after": schema.Int64Attribute{
+				Optional: true,
+			},
+			"external_oauth": schema.SingleNestedAttribute{
+				Attributes: map[string]schema.Attribute{
+					"enabled": schema.BoolAttribute{
+						Optional: true,
+					},
+					"providers": schema.ListNestedAttribute{
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"introspection": schema.SingleNestedAttribute{
+									Attributes: map[string]schema.Attribute{
+										"cache": schema.SingleNestedAttribute{
+											Attributes: map[string]schema.Attribute{
+												"enabled": schema.BoolAttribute{
+													Optional: true,
+												},
+												"timeout": schema.Int64Attribute{
+													Optional: true,
+												},
+											},
+											Optional: true,
+										},
+										"client_id": schema.StringAttribute{
+											Optional: true,
+										},
+										"client_secret": schema.StringAttribute{
+											Optional: true,
+										},
+										"enabled": schema.BoolAttribute{
+											Optional: true,
+										},
+										"identity_base_field": schema.StringAttribute{
+											Optional: true,
+										},
+										"url": schema.StringAttribute{
+											Optional: true,
+										},
+									},
+									Optional: true,
+								},
+								"jwt": schema.SingleNestedAttribute{
+									Attributes: map[string]schema.Attribute{
+										"enabled": schema.BoolAttribute{
+											Optional: true,
+										},
+										"expires_at_validation_skew": schema.Int64Attribute{
+											Optional: true,
+										},
+										"identity_base_field": schema.StringAttribute{
+											Optional: true,
+										},
+										"issued_at_validation_skew": schema.Int64Attribute{
+											Optional: true,
+										},
+										"not_before_validation_skew": schema.Int64Attribute{
+											Optional: true,
+										},
+										"signing_method": schema.StringAttribute{
+											Optional: true,
+										},
+										"source": schema.StringAttribute{
+											Optional: true,
+										},
+									},
+									Optional: true,
+								},
+							},
+						},
+						Optional: true,
+					},
+				},
+				Optional: true,
+			},
+			"global_rate_limit": schema.SingleNestedAttribute{
+				Attributes: map[string]schema.Attribute{
+					"disabled": schema.BoolAttribute{
+						Optional: true,
+					},
+					"per": schema.Float64Attribute{
+						Optional: true,
+					},
+					"rate": schema.Float64Attribute{
+						Optional: true,
+					},
+				},
+				Optional: true,
+			},
+			"graphql": schema.SingleNestedAttribute{
+				Attributes: map[string]schema.Attribute{
+					"enabled": schema.BoolAttribute{
+						Optional: true,
+					},
+					"engine": schema.SingleNestedAttribute{
+						Attributes: map[string]schema.Attribute{
+							"data_sources": schema.ListNestedAttribute{
+								NestedObject: schema.NestedAttributeObject{
+									Attributes: map[string]schema.Attribute{
+										"internal": schema.BoolAttribute{
+											Optional: true,
+										},
+										"kind": schema.StringAttribute{
+											Optional: true,
+										},
+										"name": schema.StringAttribute{
+											Optional: true,
+										},
+										"root_fields": schema.ListNestedAttribute{
+											NestedObject: schema.NestedAttributeObject{
+												Attributes: map[string]schema.Attribute{
+													"fields": schema.ListAttribute{
+														ElementType: types.StringType,
+														Optional:    true,
+													},
+													"type": schema.StringAttribute{
+														Optional: true,
+													},
+												},
+											},
+											Optional: true,
+										},
+									},
+								},
+								Optional: true,
+							},
+							"field_configs": schema.ListNestedAttribute{
+								NestedObject: schema.NestedAttributeObject{
+									Attributes: map[string]schema.Attribute{
+										"disable_default_mapping": schema.BoolAttribute{
+											Optional: true,
+										},
+										"field_name": schema.StringAttribute{
+											Optional: true,
+										},
+										"path": schema.ListAttribute{
+											ElementType: types.StringType,
+											Optional:    true,
+										},
+										"type_name": schema.StringAttribute{
+											Optional: true,
+										},
+									},
+								},
+								Optional: true,
+							},
+							"global_headers": schema.ListNestedAttribute{
+								NestedObject: schema.NestedAttributeObject{
+									Attributes: map[string]schema.Attribute{
+										"key": schema.StringAttribute{
+											Optional: true,
+										},
+										"value": schema.StringAttribute{
+											Optional: true,
+										},
+									},
+								},
+								Optional: true,
+							},
+						},
+						Optional: true,
+					},
+					"execution_mode": schema.StringAttribute{
+						Optional: true,
+					},
+					"introspection": schema.SingleNestedAttribute{
+						Attributes: map[string]schema.Attribute{
+							"disabled": schema.BoolAttribute{
+								Optional: true,
+							},
+						},
+						Optional: true,
+					},
+					"last_schema_update": schema.StringAttribute{
+						Optional: true,
+					},
+					"playground": schema.SingleNestedAttribute{
+						Attributes: map[string]schema.Attribute{
+							"enabled": schema.BoolAttribute{
+								Optional: true,
+							},
+							"path": schema.StringAttribute{
+								Optional: true,
+							},
+						},
+						Optional: true,
+					},
+					"proxy": schema.SingleNestedAttribute{
+						Attributes: map[string]schema.Attribute{
+							"auth_headers": schema.MapAttribute{
+								ElementType: types.StringType,
+								Optional:    true,
+							},
+							"features": schema.SingleNestedAttribute{
+								Attributes: map[string]schema.Attribute{
+									"use_immutable_headers": schema.BoolAttribute{
+										Optional: true,
+									},
+								},
+								Optional: true,
+							},
+							"request_headers": schema.MapAttribute{
+								ElementType: types.StringType,
+								Optional:    true,
+							},
+							"request_headers_rewrite": schema.MapNestedAttribute{
+								NestedObject: schema.NestedAttributeObject{
+									Attributes: map[string]schema.Attribute{
+										"remove": schema.BoolAttribute{
+											Optional: true,
+										},
+										"value": schema.StringAttribute{
+											Optional: true,
+										},
+									},
+								},
+								Optional: true,
+							},
+							"subscription_type": schema.StringAttribute{
+								Optional: true,
+							},
+							"use_response_extensions": schema.SingleNestedAttribute{
+								Attributes: map[string]schema.Attribute{
+									"on_error_forwarding": schema.BoolAttribute{
+										Optional: true,
+									},
+								},
+								Optional: true,
+							},
+						},
+						Optional: true,
+					},
+					"schema": schema.StringAttribute{
+						Optional: true,
+					},
+					"subgraph": schema.SingleNestedAttribute{
+						Attributes: map[string]schema.Attribute{
+							"sdl": schema.StringAttribute{
+								Optional: true,
+							},
+						},
+						Optional: true,
+					},
+					"supergraph": schema.SingleNestedAttribute{
+						Attributes: map[string]schema.Attribute{
+							"disable_query_batching": schema.BoolAttribute{
+								Optional: true,
+							},
+							"global_headers": schema.MapAttribute{
+								ElementType: types.StringType,
+								Optional:    true,
+							},
+							"merged_sdl": schema.StringAttribute{
+								Optional: true,
+							},
+							"subgraphs": schema.ListNestedAttribute{
+								NestedObject: schema.NestedAttributeObject{
+									Attributes: map[string]schema.Attribute{
+										"api_id": schema.StringAttribute{
+											Optional: true,
+										},
+										"headers": schema.MapAttribute{
+											ElementType: types.StringType,
+											Optional:    true,
+										},
+										"name": schema.StringAttribute{
+											Optional: true,
+										},
+										"sdl": schema.StringAttribute{
+											Optional: true,
+										},
+										"subscription_type": schema.StringAttribute{
+											Optional: true,
+										},
+										"url": schema.StringAttribute{
+											Optional: true,
+										},
+									},
+								},
+								Optional: true,
+							},
+							"updated_at": schema.StringAttribute{
+								Optional: true,
+							},
+						},
+						Optional: true,
+					},
+					"type_field_configurations": schema.ListNestedAttribute{
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"data_source": schema.SingleNestedAttribute{
+									Attributes: map[string]schema.Attribute{
+										"kind": schema.StringAttribute{
+											Optional: true,
+										},
+									},
+									Optional: true,
+								},
+								"field_name": schema.StringAttribute{
+									Optional: true,
+								},
+								"mapping": schema.SingleNestedAttribute{
+									Attributes: map[string]schema.Attribute{
+										"disabled": schema.BoolAttribute{
+											Optional: true,
+										},
+										"path": schema.StringAttribute{
+											Optional: true,
+										},
+									},
+									Optional: true,
+								},
+								"type_name": schema.StringAttribute{
+									Optional: true,
+								},
+							},
+						},
+						Optional: true,
+					},
+					"version": schema.StringAttribute{
+						Optional: true,
+					},
+				},
+				Optional: true,
+			},
+			"hmac_allowed_algorithms": schema.ListAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"hmac_allowed_clock_skew": schema.Float64Attribute{
+				Optional: true,
+			},
+			"id": schema.StringAttribute{
+				Optional: true,
+			},
+			"idp_client_id_mapping_disabled": schema.BoolAttribute{
+				Optional: true,
+			},
+			"internal": schema.BoolAttribute{
+				Optional: true,
+			},
+			"is_oas": schema.BoolAttribute{
+				Optional: true,
+			},
+			"jwt_client_base_field": schema.StringAttribute{
+				Optional: true,
+			},
+			"jwt_default_policies": schema.ListAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"jwt_expires_at_validation_skew": schema.Int64Attribute{
+				Optional: true,
+			},
+			"jwt_identity_base_field": schema.StringAttribute{
+				Optional: true,
+			},
+			"jwt_issued_at_validation_skew": schema.Int64Attribute{
+				Optional: true,
+			},
+			"jwt_not_before_validation_skew": schema.Int64Attribute{
+				Optional: true,
+			},
+			"jwt_policy_field_name": schema.StringAttribute{
+				Optional: true,
+			},
+			"jwt_scope_claim_name": schema.StringAttribute{
+				Optional: true,
+			},
+			"jwt_scope_to_policy_mapping": schema.MapAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"jwt_signing_method": schema.StringAttribute{
+				Optional: true,
+			},
+			"jwt_skip_kid": schema.BoolAttribute{
+				Optional: true,
+			},
+			"jwt_source": schema.StringAttribute{
+				Optional: true,
+			},
+			"listen_port": schema.Int64Attribute{
+				Optional: true,
+			},
+			"name": schema.StringAttribute{
+				Optional: true,
+			},
+			"notifications": schema.SingleNestedAttribute{
+				Attributes: map[string]schema.Attribute{
+					"oauth_on_keychange_url": schema.StringAttribute{
+						Optional: true,
+					},
+					"shared_secret": schema.StringAttribute{
+						Optional: true,
+					},
+				},
+				Optional: true,
+			},
+			"oauth_meta": schema.SingleNestedAttribute{
+				Attributes: map[string]schema.Attribute{
+					"allowed_access_types": schema.ListAttribute{
+						ElementType: types.StringType,
+						Optional:    true,
+					},
+					"allowed_authorize_types": schema.ListAttribute{
+						ElementType: types.StringType,
+						Optional:    true,
+					},
+					"auth_login_redirect": schema.StringAttribute{
+						Optional: true,
+					},
+				},
+				Optional: true,
+			},
+			"openid_options": schema.SingleNestedAttribute{
+				Attributes: map[string]schema.Attribute{
+					"providers": schema.ListNestedAttribute{
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"client_ids": schema.MapAttribute{
+									ElementType: types.StringType,
+									Optional:    true,
+								},
+								"issuer": schema.StringAttribute{
+									Optional: true,
+								},
+							},
+						},
+						Optional: true,
+					},
+					"segregate_by_client": schema.BoolAttribute{
+						Optional: true,
+					},
+				},
+				Optional: true,
+			},
+			"org_id": schema.StringAttribute{
+				Optional: true,
+			},
+			"pinned_public_keys": schema.MapAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"protocol": schema.StringAttribute{
+				Optional: true,
+			},
+			"proxy": schema.SingleNestedAttribute{
+				Attributes: map[string]schema.Attribute{
+					"check_host_against_uptime_tests": schema.BoolAttribute{
+						Optional: true,
+					},
+					"disable_strip_slash": schema.BoolAttribute{
+						Optional: true,
+					},
+					"enable_load_balancing": schema.BoolAttribute{
+						Optional: true,
+					},
+					"listen_path": schema.StringAttribute{
+						Optional: true,
+					},
+					"preserve_host_header": schema.BoolAttribute{
+						Optional: true,
+					},
+					"service_discovery": schema.SingleNestedAttribute{
+						Attributes: map[string]schema.Attribute{
+							"cache_disabled": schema.BoolAttribute{
+								Optional: true,
+							},
+							"cache_timeout": schema.Int64Attribute{
+								Optional: true,
+							},
+							"data_path": schema.StringAttribute{
+								Optional: true,
+							},
+							"endpoint_returns_list": schema.BoolAttribute{
+								Optional: true,
+							},
+							"parent_data_path": schema.StringAttribute{
+								Optional: true,
+							},
+							"port_data_path": schema.StringAttribute{
+								Optional: true,
+							},
+							"query_endpoint": schema.StringAttribute{
+								Optional: true,
+							},
+							"target_path": schema.StringAttribute{
+								Optional: true,
+							},
+							"use_discovery_service": schema.BoolAttribute{
+								Optional: true,
+							},
+							"use_nested_query": schema.BoolAttribute{
+								Optional: true,
+							},
+							"use_target_list": schema.BoolAttribute{
+								Optional: true,
+							},
+						},
+						Optional: true,
+					},
+					"strip_listen_path": schema.BoolAttribute{
+						Optional: true,
+					},
+					"target_list": schema.ListAttribute{
+						ElementType: types.StringType,
+						Optional:    true,
+					},
+					"target_url": schema.StringAttribute{
+						Optional: true,
+					},
+					"transport": schema.SingleNestedAttribute{
+						Attributes: map[string]schema.Attribute{
+							"proxy_url": schema.StringAttribute{
+								Optional: true,
+							},
+							"ssl_ciphers": schema.ListAttribute{
+								ElementType: types.StringType,
+								Optional:    true,
+							},
+							"ssl_force_common_name_check": schema.BoolAttribute{
+								Optional: true,
+							},
+							"ssl_insecure_skip_verify": schema.BoolAttribute{
+								Optional: true,
+							},
+							"ssl_max_version": schema.Int64Attribute{
+								Optional: true,
+							},
+							"ssl_min_version": schema.Int64Attribute{
+								Optional: true,
+							},
+						},
+						Optional: true,
+					},
+				},
+				Optional: true,
+			},
+			"request_signing": schema.SingleNestedAttribute{
+				Attributes: map[string]schema.Attribute{
+					"algorithm": schema.StringAttribute{
+						Optional: true,
+					},
+					"certificate_id": schema.StringAttribute{
+						Optional: true,
+					},
+					"header_list": schema.ListAttribute{
+						ElementType: types.StringType,
+						Optional:    true,
+					},
+					"is_enabled": schema.BoolAttribute{
+						Optional: true,
+					},
+					"key_id": schema.StringAttribute{
+						Optional: true,
+					},
+					"secret": schema.StringAttribute{
+						Optional: true,
+					},
+					"signature_header": schema.StringAttribute{
+						Optional: true,
+					},
+				},
+				Optional: true,
+			},
+			"response_processors": schema.ListNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Optional: true,
+						},
+					},
+				},
+				Optional: true,
+			},
+			"scopes": schema.SingleNestedAttribute{
+				Attributes: map[string]schema.Attribute{
+					"jwt": schema.SingleNestedAttribute{
+						Attributes: map[string]schema.Attribute{
+							"scope_claim_name": schema.StringAttribute{
+								Optional: true,
+							},
+							"scope_to_policy": schema.MapAttribute{
+								ElementType: types.StringType,
+								Optional:    true,
+							},
+						},
+						Optional: true,
+					},
+					"oidc": schema.SingleNestedAttribute{
+						Attributes: map[string]schema.Attribute{
+							"scope_claim_name": schema.StringAttribute{
+								Optional: true,
+							},
+							"scope_to_policy": schema.MapAttribute{
+								ElementType: types.StringType,
+								Optional:    true,
+							},
+						},
+						Optional: true,
+					},
+				},
+				Optional: true,
+			},
+			"session_lifetime": schema.Int64Attribute{
+				Optional: true,
+			},
+			"session_lifetime_respects_key_expiration": schema.BoolAttribute{
+				Optional: true,
+			},
+			"session_provider": schema.SingleNestedAttribute{
+				Attributes: map[string]schema.Attribute{
+					"name": schema.StringAttribute{
+						Optional: true,
+					},
+					"storage_engine": schema.StringAttribute{
+						Optional: true,
+					},
+				},
+				Optional: true,
+			},
+			"slug": schema.StringAttribute{
+				Optional: true,
+			},
+			"strip_auth_data": schema.BoolAttribute{
+				Optional: true,
+			},
+			"tag_headers": schema.ListAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"tags": schema.ListAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"tags_disabled": schema.BoolAttribute{
+				Optional: true,
+			},
+			"upstream_certificates": schema.MapAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"upstream_certificates_disabled": schema.BoolAttribute{
+				Optional: true,
+			},
+			"uptime_tests": schema.SingleNestedAttribute{
+				Attributes: map[string]schema.Attribute{
+					"check_list": schema.ListNestedAttribute{
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"body": schema.StringAttribute{
+									Optional: true,
+								},
+								"commands": schema.ListNestedAttribute{
+									NestedObject: schema.NestedAttributeObject{
+										Attributes: map[string]schema.Attribute{
+											"message": schema.StringAttribute{
+												Optional: true,
+											},
+											"name": schema.StringAttribute{
+												Optional: true,
+											},
+										},
+									},
+									Optional: true,
+								},
+								"enable_proxy_protocol": schema.BoolAttribute{
+									Optional: true,
+								},
+								"headers": schema.MapAttribute{
+									ElementType: types.StringType,
+									Optional:    true,
+								},
+								"method": schema.StringAttribute{
+									Optional: true,
+								},
+								"protocol": schema.StringAttribute{
+									Optional: true,
+								},
+								"timeout": schema.Int64Attribute{
+									Optional: true,
+								},
+								"url": schema.StringAttribute{
+									Optional: true,
+								},
+							},
+						},
+						Optional: true,
+					},
+					"config": schema.SingleNestedAttribute{
+						Attributes: map[string]schema.Attribute{
+							"expire_utime_after": schema.Int64Attribute{
+								Optional: true,
+							},
+							"recheck_wait": schema.Int64Attribute{
+								Optional: true,
+							},
+							"service_discovery": schema.SingleNestedAttribute{
+								Attributes: map[string]schema.Attribute{
+									"cache_disabled": schema.BoolAttribute{
+										Optional: true,
+									},
+									"cache_timeout": schema.Int64Attribute{
+										Optional: true,
+									},
+									"data_path": schema.StringAttribute{
+										Optional: true,
+									},
+									"endpoint_returns_list": schema.BoolAttribute{
+										Optional: true,
+									},
+									"parent_data_path": schema.StringAttribute{
+										Optional: true,
+									},
+									"port_data_path": schema.StringAttribute{
+										Optional: true,
+									},
+									"query_endpoint": schema.StringAttribute{
+										Optional: true,
+									},
+									"target_path": schema.StringAttribute{
+										Optional: true,
+									},
+									"use_discovery_service": schema.BoolAttribute{
+										Optional: true,
+									},
+									"use_nested_query": schema.BoolAttribute{
+										Optional: true,
+									},
+									"use_target_list": schema.BoolAttribute{
+										Optional: true,
+									},
+								},
+								Optional: true,
+							},
+						},
+						Optional: true,
+					},
+				},
+				Optional: true,
+			},
+			"use_basic_auth": schema.BoolAttribute{
+				Optional: true,
+			},
+			"use_go_plugin_auth": schema.BoolAttribute{
+				Optional: true,
+			},
+			"use_keyless": schema.BoolAttribute{
+				Optional: true,
+			},
+			"use_mutual_tls_auth": schema.BoolAttribute{
+				Optional: true,
+			},
+			"use_oauth2": schema.BoolAttribute{
+				Optional: true,
+			},
+			"use_openid": schema.BoolAttribute{
+				Optional: true,
+			},
+			"use_standard_auth": schema.BoolAttribute{
+				Optional: true,
+			},
+			"version_data": schema.SingleNestedAttribute{
+				Attributes: map[string]schema.Attribute{
+					"default_version": schema.StringAttribute{
+						Optional: true,
+					},
+					"not_versioned": schema.BoolAttribute{
+						Optional: true,
+					},
+					"versions": schema.MapNestedAttribute{
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"expires": schema.StringAttribute{
+									Optional: true,
+								},
+								"extended_paths": schema.SingleNestedAttribute{
+									Attributes: map[string]schema.Attribute{
+										"advance_cache_config": schema.ListNestedAttribute{
+											NestedObject: schema.NestedAttributeObject{
+												Attributes: map[string]schema.Attribute{
+													"cache_key_regex": schema.StringAttribute{
+														Optional: true,
+													},
+													"cache_response_codes": schema.ListAttribute{
+														ElementType: types.Int64Type,
+														Optional:    true,
+													},
+													"disabled": schema.BoolAttribute{
+														Optional: true,
+													},
+													"method": schema.StringAttribute{
+														Optional: true,
+													},
+													"path": schema.StringAttribute{
+														Optional: true,
+													},
+													"timeout": schema.Int64Attribute{
+														Optional: true,
+													},
+												},
+											},
+											Optional: true,
+										},
+										"black_list": schema.ListNestedAttribute{
+											NestedObject: schema.NestedAttributeObject{
+												Attributes: map[string]schema.Attribute{
+													"disabled": schema.BoolAttribute{
+														Optional: true,
+													},
+													"ignore_case": schema.BoolAttribute{
+														Optional: true,
+													},
+													"method": schema.StringAttribute{
+														Optional: true,
+													},
+													"method_actions": schema.MapNestedAttribute{
+														NestedObject: schema.NestedAttributeObject{
+															Attributes: map[string]schema.Attribute{
+																"action": schema.StringAttribute{
+																	Optional: true,
+																},
+																"code": schema.Int64Attribute{
+																	Optional: true,
+																},
+																"data": schema.StringAttribute{
+																	Optional: true,
+																},
+																"headers": schema.MapAttribute{
+																	ElementType: types.StringType,
+																	Optional:    true,
+																},
+															},
+														},
+														Optional: true,
+													},
+													"path": schema.StringAttribute{
+														Optional: true,
+													},
+												},
+											},
+											Optional: true,
+										},
+										"cache": schema.ListAttribute{
+											ElementType: types.StringType,
+											Optional:    true,
+										},
+										"circuit_breakers": schema.ListNestedAttribute{
+											NestedObject: schema.NestedAttributeObject{
+												Attributes: map[string]schema.Attribute{
+													"disable_half_open_state": schema.BoolAttribute{
+														Optional: true,
+													},
+													"disabled": schema.BoolAttribute{
+														Optional: true,
+													},
+													"method": schema.StringAttribute{
+														Optional: true,
+													},
+													"path": schema.StringAttribute{
+														Optional: true,
+													},
+													"return_to_service_after": schema.Int64Attribute{
+														Optional: true,
+													},
+													"samples": schema.Int64Attribute{
+														Optional: true,
+													},
+													"threshold_percent": schema.Float64Attribute{
+														Optional: true,
+													},
+												},
+											},
+											Optional: true,
+										},
+										"do_not_track_endpoints": schema.ListNestedAttribute{
+											NestedObject: schema.NestedAttributeObject{
+												Attributes: map[string]schema.Attribute{
+													"disabled": schema.BoolAttribute{
+														Optional: true,
+													},
+													"method": schema.StringAttribute{
+														Optional: true,
+													},
+													"path": schema.StringAttribute{
+														Optional: true,
+													},
+												},
+											},
+											Optional: true,
+										},
+										"go_plugin": schema.ListNestedAttribute{
+											NestedObject: schema.NestedAttributeObject{
+												Attributes: map[string]schema.Attribute{
+													"disabled": schema.BoolAttribute{
+														Optional: true,
+													},
+													"func_name": schema.StringAttribute{
+														Optional: true,
+													},
+													"method": schema.StringAttribute{
+														Optional: true,
+													},
+													"path": schema.StringAttribute{
+														Optional: true,
+													},
+													"plugin_path": schema.StringAttribute{
+														Optional: true,
+													},
+												},
+											},
+											Optional: true,
+										},
+										"hard_timeouts": schema.ListNestedAttribute{
+											NestedObject: schema.NestedAttributeObject{
+												Attributes: map[string]schema.Attribute{
+													"disabled": schema.BoolAttribute{
+														Optional: true,
+													},
+													"method": schema.StringAttribute{
+														Optional: true,
+													},
+													"path": schema.StringAttribute{
+														Optional: true,
+													},
+													"timeout": schema.Int64Attribute{
+														Optional: true,
+													},
+												},
+											},
+											Optional: true,
+										},
+										"ignored": schema.ListNestedAttribute{
+											NestedObject: schema.NestedAttributeObject{
+												Attributes: map[string]schema.Attribute{
+													"disabled": schema.BoolAttribute{
+														Optional: true,
+													},
+													"ignore_case": schema.BoolAttribute{
+														Optional: true,
+													},
+													"method": schema.StringAttribute{
+														Optional: true,
+													},
+													"method_actions": schema.MapNestedAttribute{
+														NestedObject: schema.NestedAttributeObject{
+															Attributes: map[string]schema.Attribute{
+																"action": schema.StringAttribute{
+																	Optional: true,
+																},
+																"code": schema.Int64Attribute{
+																	Optional: true,
+																},
+																"data": schema.StringAttribute{
+																	Optional: true,
+																},
+																"headers": schema.MapAttribute{
+																	ElementType: types.StringType,
+																	Optional:    true,
+																},
+															},
+														},
+														Optional: true,
+													},
+													"path": schema.StringAttribute{
+														Optional: true,
+													},
+												},
+											},
+											Optional: true,
+										},
+										"internal": schema.ListNestedAttribute{
+											NestedObject: schema.NestedAttributeObject{
+												Attributes: map[string]schema.Attribute{
+													"disabled": schema.BoolAttribute{
+														Optional: true,
+													},
+													"method": schema.StringAttribute{
+														Optional: true,
+													},
+													"path": schema.StringAttribute{
+														Optional: true,
+													},
+												},
+											},
+											Optional: true,
+										},
+										"method_transforms": schema.ListNestedAttribute{
+											NestedObject: schema.NestedAttributeObject{
+												Attributes: map[string]schema.Attribute{
+													"disabled": schema.BoolAttribute{
+														Optional: true,
+													},
+													"method": schema.StringAttribute{
+														Optional: true,
+													},
+													"path": schema.StringAttribute{
+														Optional: true,
+													},
+													"to_method": schema.StringAttribute{
+														Optional: true,
+													},
+												},
+											},
+											Optional: true,
+										},
+										"mock_response": schema.ListNestedAttribute{
+											NestedObject: schema.NestedAttributeObject{
+												Attributes: map[string]schema.Attribute{
+													"body": schema.StringAttribute{
+														Optional: true,
+													},
+													"code": schema.Int64Attribute{
+														Optional: true,
+													},
+													"disabled": schema.BoolAttribute{
+														Optional: true,
+													},
+													"headers": schema.MapAttribute{
+														ElementType: types.StringType,
+														Optional:    true,
+													},
+													"ignore_case": schema.BoolAttribute{
+														Optional: true,
+													},
+													"method": schema.StringAttribute{
+														Optional: true,
+													},
+													"path": schema.StringAttribute{
+														Optional: true,
+													},
+												},
+											},
+											Optional: true,
+										},
+										"persist_graphql": schema.ListNestedAttribute{
+											NestedObject: schema.NestedAttributeObject{
+												Attributes: map[string]schema.Attribute{
+													"method": schema.StringAttribute{
+														Optional: true,
+													},
+													"operation": schema.StringAttribute{
+														Optional: true,
+													},
+													"path": schema.StringAttribute{
+														Optional: true,
+													},
+												},
+											},
+											Optional: true,
+										},
+										"rate_limit": schema.ListNestedAttribute{
+											NestedObject: schema.NestedAttributeObject{
+												Attributes: map[string]schema.Attribute{
+													"disabled": schema.BoolAttribute{
+														Optional: true,
+													},
+													"method": schema.StringAttribute{
+														Optional: true,
+													},
+													"path": schema.StringAttribute{
+														Optional: true,
+													},
+													"per": schema.Float64Attribute{
+														Optional: true,
+													},
+													"rate": schema.Float64Attribute{
+														Optional: true,
+													},
+												},
+											},
+											Optional: true,
+										},
+										"size_limits": schema.ListNestedAttribute{
+											NestedObject: schema.NestedAttributeObject{
+												Attributes: map[string]schema.Attribute{
+													"disabled": schema.BoolAttribute{
+														Optional: true,
+													},
+													"method": schema.StringAttribute{
+														Optional: true,
+													},
+													"path": schema.StringAttribute{
+														Optional: true,
+													},
+													"size_limit": schema.Int64Attribute{
+														Optional: true,
+													},
+												},
+											},
+											Optional: true,
+										},
+										"track_endpoints": schema.ListNestedAttribute{
+											NestedObject: schema.NestedAttributeObject{
+												Attributes: map[string]schema.Attribute{
+													"disabled": schema.BoolAttribute{
+														Optional: true,
+													},
+													"method": schema.StringAttribute{
+														Optional: true,
+													},
+													"path": schema.StringAttribute{
+														Optional: true,
+													},
+												},
+											},
+											Optional: true,
+										},
+										"transform": schema.ListNestedAttribute{
+											NestedObject: schema.NestedAttributeObject{
+												Attributes: map[string]schema.Attribute{
+													"disabled": schema.BoolAttribute{
+														Optional: true,
+													},
+													"method": schema.StringAttribute{
+														Optional: true,
+													},
+													"path": schema.StringAttribute{
+														Optional: true,
+													},
+													"template_data": schema.SingleNestedAttribute{
+														Attributes: map[string]schema.Attribute{
+															"enable_session": schema.BoolAttribute{
+																Optional: true,
+															},
+															"input_type": schema.StringAttribute{
+																Optional: true,
+															},
+															"template_mode": schema.StringAttribute{
+																Optional: true,
+															},
+															"template_source": schema.StringAttribute{
+																Optional: true,
+															},
+														},
+														Optional: true,
+													},
+												},
+											},
+											Optional: true,
+										},
+										"transform_headers": schema.ListNestedAttribute{
+											NestedObject: schema.NestedAttributeObject{
+												Attributes: map[string]schema.Attribute{
+													"act_on": schema.BoolAttribute{
+														Optional: true,
+													},
+													"add_headers": schema.MapAttribute{
+														ElementType: types.StringType,
+														Optional:    true,
+													},
+													"delete_headers": schema.ListAttribute{
+														ElementType: types.StringType,
+														Optional:    true,
+													},
+													"disabled": schema.BoolAttribute{
+														Optional: true,
+													},
+													"method": schema.StringAttribute{
+														Optional: true,
+													},
+													"path": schema.StringAttribute{
+														Optional: true,
+													},
+												},
+											},
+											Optional: true,
+										},
+										"transform_jq": schema.ListNestedAttribute{
+											NestedObject: schema.NestedAttributeObject{
+												Attributes: map[string]schema.Attribute{
+													"filter": schema.StringAttribute{
+														Optional: true,
+													},
+													"method": schema.StringAttribute{
+														Optional: true,
+													},
+													"path": schema.StringAttribute{
+														Optional: true,
+													},
+												},
+											},
+											Optional: true,
+										},
+										"transform_jq_response": schema.ListNestedAttribute{
+											NestedObject: schema.NestedAttributeObject{
+												Attributes: map[string]schema.Attribute{
+													"filter": schema.StringAttribute{
+														Optional: true,
+													},
+													"method": schema.StringAttribute{
+														Optional: true,
+													},
+													"path": schema.StringAttribute{
+														Optional: true,
+													},
+												},
+											},
+											Optional: true,
+										},
+										"transform_response": schema.ListNestedAttribute{
+											NestedObject: schema.NestedAttributeObject{
+												Attributes: map[string]schema.Attribute{
+													"disabled": schema.BoolAttribute{
+														Optional: true,
+													},
+													"method": schema.StringAttribute{
+														Optional: true,
+													},
+													"path": schema.StringAttribute{
+														Optional: true,
+													},
+													"template_data": schema.SingleNestedAttribute{
+														Attributes: map[string]schema.Attribute{
+															"enable_session": schema.BoolAttribute{
+																Optional: true,
+															},
+															"input_type": schema.StringAttribute{
+																Optional: true,
+															},
+															"template_mode": schema.StringAttribute{
+																Optional: true,
+															},
+															"template_source": schema.StringAttribute{
+																Optional: true,
+															},
+														},
+														Optional: true,
+													},
+												},
+											},
+											Optional: true,
+										},
+										"transform_response_headers": schema.ListNestedAttribute{
+											NestedObject: schema.NestedAttributeObject{
+												Attributes: map[string]schema.Attribute{
+													"act_on": schema.BoolAttribute{
+														Optional: true,
+													},
+													"add_headers": schema.MapAttribute{
+														ElementType: types.StringType,
+														Optional:    true,
+													},
+													"delete_headers": schema.ListAttribute{
+														ElementType: types.StringType,
+														Optional:    true,
+													},
+													"disabled": schema.BoolAttribute{
+														Optional: true,
+													},
+													"method": schema.StringAttribute{
+														Optional: true,
+													},
+													"path": schema.StringAttribute{
+														Optional: true,
+													},
+												},
+											},
+											Optional: true,
+										},
+										"url_rewrites": schema.ListNestedAttribute{
+											NestedObject: schema.NestedAttributeObject{
+												Attributes: map[string]schema.Attribute{
+													"disabled": schema.BoolAttribute{
+														Optional: true,
+													},
+													"match_pattern": schema.StringAttribute{
+														Optional: true,
+													},
+													"method": schema.StringAttribute{
+														Optional: true,
+													},
+													"path": schema.StringAttribute{
+														Optional: true,
+													},
+													"rewrite_to": schema.StringAttribute{
+														Optional: true,
+													},
+													"triggers": schema.ListNestedAttribute{
+														NestedObject: schema.NestedAttributeObject{
+															Attributes: map[string]schema.Attribute{
+																"on": schema.StringAttribute{
+																	Optional: true,
+																},
+																"options": schema.SingleNestedAttribute{
+																	Attributes: map[string]schema.Attribute{
+																		"header_matches": schema.MapNestedAttribute{
+																			NestedObject: schema.NestedAttributeObject{
+																				Attributes: map[string]schema.Attribute{
+																					"match_rx": schema.StringAttribute{
+																						Optional: true,
+																					},
+																					"reverse": schema.BoolAttribute{
+																						Optional: true,
+																					},
+																				},
+																			},
+																			Optional: true,
+																		},
+																		"path_part_matches": schema.MapNestedAttribute{
+																			NestedObject: schema.NestedAttributeObject{
+																				Attributes: map[string]schema.Attribute{
+																					"match_rx": schema.StringAttribute{
+																						Optional: true,
+																					},
+																					"reverse": schema.BoolAttribute{
+																						Optional: true,
+																					},
+																				},
+																			},
+																			Optional: true,
+																		},
+																		"payload_matches": schema.SingleNestedAttribute{
+																			Attributes: map[string]schema.Attribute{
+																				"match_rx": schema.StringAttribute{
+																					Optional: true,
+																				},
+																				"reverse": schema.BoolAttribute{
+																					Optional: true,
+																				},
+																			},
+																			Optional: true,
+																		},
+																		"query_val_matches": schema.MapNestedAttribute{
+																			NestedObject: schema.NestedAttributeObject{
+																				Attributes: map[string]schema.Attribute{
+																					"match_rx": schema.StringAttribute{
+																						Optional: true,
+																					},
+																					"reverse": schema.BoolAttribute{
+																						Optional: true,
+																					},
+																				},
+																			},
+																			Optional: true,
+																		},
+																		"request_context_matches": schema.MapNestedAttribute{
+																			NestedObject: schema.NestedAttributeObject{
+																				Attributes: map[string]schema.Attribute{
+																					"match_rx": schema.StringAttribute{
+																						Optional: true,
+																					},
+																					"reverse": schema.BoolAttribute{
+																						Optional: true,
+																					},
+																				},
+																			},
+																			Optional: true,
+																		},
+																		"session_meta_matches": schema.MapNestedAttribute{
+																			NestedObject: schema.NestedAttributeObject{
+																				Attributes: map[string]schema.Attribute{
+																					"match_rx": schema.StringAttribute{
+																						Optional: true,
+																					},
+																					"reverse": schema.BoolAttribute{
+																						Optional: true,
+																					},
+																				},
+																			},
+																			Optional: true,
+																		},
+																	},
+																	Optional: true,
+																},
+																"rewrite_to": schema.StringAttribute{
+																	Optional: true,
+																},
+															},
+														},
+														Optional: true,
+													},
+												},
+											},
+											Optional: true,
+										},
+										"validate_json": schema.ListNestedAttribute{
+											NestedObject: schema.NestedAttributeObject{
+												Attributes: map[string]schema.Attribute{
+													"disabled": schema.BoolAttribute{
+														Optional: true,
+													},
+													"error_response_code": schema.Int64Attribute{
+														Optional: true,
+													},
+													"method": schema.StringAttribute{
+														Optional: true,
+													},
+													"path": schema.StringAttribute{
+														Optional: true,
+													},
+													"schema_b64": schema.StringAttribute{
+														Optional: true,
+													},
+												},
+											},
+											Optional: true,
+										},
+										"validate_request": schema.ListNestedAttribute{
+											NestedObject: schema.NestedAttributeObject{
+												Attributes: map[string]schema.Attribute{
+													"enabled": schema.BoolAttribute{
+														Optional: true,
+													},
+													"error_response_code": schema.Int64Attribute{
+														Optional: true,
+													},
+													"method": schema.StringAttribute{
+														Optional: true,
+													},
+													"path": schema.StringAttribute{
+														Optional: true,
+													},
+												},
+											},
+											Optional: true,
+										},
+										"virtual": schema.ListNestedAttribute{
+											NestedObject: schema.NestedAttributeObject{
+												Attributes: map[string]schema.Attribute{
+													"disabled": schema.BoolAttribute{
+														Optional: true,
+													},
+													"function_source_type": schema.StringAttribute{
+														Optional: true,
+													},
+													"function_source_uri": schema.StringAttribute{
+														Optional: true,
+													},
+													"method": schema.StringAttribute{
+														Optional: true,
+													},
+													"path": schema.StringAttribute{
+														Optional: true,
+													},
+													"proxy_on_error": schema.BoolAttribute{
+														Optional: true,
+													},
+													"response_function_name": schema.StringAttribute{
+														Optional: true,
+													},
+													"use_session": schema.BoolAttribute{
+														Optional: true,
+													},
+												},
+											},
+											Optional: true,
+										},
+										"white_list": schema.ListNestedAttribute{
+											NestedObject: schema.NestedAttributeObject{
+												Attributes: map[string]schema.Attribute{
+													"disabled": schema.BoolAttribute{
+														Optional: true,
+													},
+													"ignore_case": schema.BoolAttribute{
+														Optional: true,
+													},
+													"method": schema.StringAttribute{
+														Optional: true,
+													},
+													"method_actions": schema.MapNestedAttribute{
+														NestedObject: schema.NestedAttributeObject{
+															Attributes: map[string]schema.Attribute{
+																"action": schema.StringAttribute{
+																	Optional: true,
+																},
+																"code": schema.Int64Attribute{
+																	Optional: true,
+																},
+																"data": schema.StringAttribute{
+																	Optional: true,
+																},
+																"headers": schema.MapAttribute{
+																	ElementType: types.StringType,
+																	Optional:    true,
+																},
+															},
+														},
+														Optional: true,
+													},
+													"path": schema.StringAttribute{
+														Optional: true,
+													},
+												},
+											},
+											Optional: true,
+										},
+									},
+									Optional: true,
+								},
+								"global_headers": schema.MapAttribute{
+									ElementType: types.StringType,
+									Optional:    true,
+								},
+								"global_headers_disabled": schema.BoolAttribute{
+									Optional: true,
+								},
+								"global_headers_remove": schema.ListAttribute{
+									ElementType: types.StringType,
+									Optional:    true,
+								},
+								"global_response_headers": schema.MapAttribute{
+									ElementType: types.StringType,
+									Optional:    true,
+								},
+								"global_response_headers_disabled": schema.BoolAttribute{
+									Optional: true,
+								},
+								"global_response_headers_remove": schema.ListAttribute{
+									ElementType: types.StringType,
+									Optional:    true,
+								},
+								"global_size_limit": schema.Int64Attribute{
+									Optional: true,
+								},
+								"ignore_endpoint_case": schema.BoolAttribute{
+									Optional: true,
+								},
+								"name": schema.StringAttribute{
+									Optional: true,
+								},
+								"override_target": schema.StringAttribute{
+									Optional: true,
+								},
+								"paths": schema.SingleNestedAttribute{
+									Attributes: map[string]schema.Attribute{
+										"black_list": schema.ListAttribute{
+											ElementType: types.StringType,
+											Optional:    true,
+										},
+										"ignored": schema.ListAttribute{
+											ElementType: types.StringType,
+											Optional:    true,
+										},
+										"white_list": schema.ListAttribute{
+											ElementType: types.StringType,
+											Optional:    true,
+										},
+									},
+									Optional: true,
+								},
+								"use_extended_paths": schema.BoolAttribute{
+									Optional: true,
+								},
+							},
+						},
+						Optional: true,
+					},
+				},
+				Optional: true,
+			},
+		},
+	}
+}
+
+// ApiDefinitionModel is the model of the api_definition resource schema.
+type ApiDefinitionModel struct {
+	Active                               types.Bool    `tfsdk:"active"`
+	AllowedIps                           types.List    `tfsdk:"allowed_ips"`
+	AnalyticsPlugin                      types.Object  `tfsdk:"analytics_plugin"`
+	ApiId                                types.String  `tfsdk:"api_id"`
+	Auth                                 types.Object  `tfsdk:"auth"`
+	AuthConfigs                          types.Map     `tfsdk:"auth_configs"`
+	AuthProvider                         types.Object  `tfsdk:"auth_provider"`
+	BaseIdentityProvidedBy               types.String  `tfsdk:"base_identity_provided_by"`
+	BasicAuth                            types.Object  `tfsdk:"basic_auth"`
+	BlacklistedIps                       types.List    `tfsdk:"blacklisted_ips"`
+	CacheOptions                         types.Object  `tfsdk:"cache_options"`
+	CertificatePinningDisabled           types.Bool    `tfsdk:"certificate_pinning_disabled"`
+	Certificates                         types.List    `tfsdk:"certificates"`
+	ClientCertificates                   types.List    `tfsdk:"client_certificates"`
+	ConfigDataDisabled                   types.Bool    `tfsdk:"config_data_disabled"`
+	Cors                                 types.Object  `tfsdk:"cors"`
+	CustomMiddleware                     types.Object  `tfsdk:"custom_middleware"`
+	CustomMiddlewareBundle               types.String  `tfsdk:"custom_middleware_bundle"`
+	CustomMiddlewareBundleDisabled       types.Bool    `tfsdk:"custom_middleware_bundle_disabled"`
+	CustomPluginAuthEnabled              types.Bool    `tfsdk:"custom_plugin_auth_enabled"`
+	Definition                           types.Object  `tfsdk:"definition"`
+	DetailedTracing                      types.Bool    `tfsdk:"detailed_tracing"`
+	DisableQuota                         types.Bool    `tfsdk:"disable_quota"`
+	DisableRateLimit                     types.Bool    `tfsdk:"disable_rate_limit"`
+	DoNotTrack                           types.Bool    `tfsdk:"do_not_track"`
+	Domain                               types.String  `tfsdk:"domain"`
+	DomainDisabled                       types.Bool    `tfsdk:"domain_disabled"`
+	DontSetQuotaOnCreate                 types.Bool    `tfsdk:"dont_set_quota_on_create"`
+	EnableBatchRequestSupport            types.Bool    `tfsdk:"enable_batch_request_support"`
+	EnableContextVars                    types.Bool    `tfsdk:"enable_context_vars"`
+	EnableCoprocessAuth                  types.Bool    `tfsdk:"enable_coprocess_auth"`
+	EnableDetailedRecording              types.Bool    `tfsdk:"enable_detailed_recording"`
+	EnableIpBlacklisting                 types.Bool    `tfsdk:"enable_ip_blacklisting"`
+	EnableIpWhitelisting                 types.Bool    `tfsdk:"enable_ip_whitelisting"`
+	EnableJwt                            types.Bool    `tfsdk:"enable_jwt"`
+	EnableProxyProtocol                  types.Bool    `tfsdk:"enable_proxy_protocol"`
+	EnableSignatureChecking              types.Bool    `tfsdk:"enable_signature_checking"`
+	EventHandlers                        types.Object  `tfsdk:"event_handlers"`
+	Expiration                           types.String  `tfsdk:"expiration"`
+	ExpireAnalyticsAfter                 types.Int64   `tfsdk:"expire_analytics_after"`
+	ExternalOauth                        types.Object  `tfsdk:"external_oauth"`
+	GlobalRateLimit                      types.Object  `tfsdk:"global_rate_limit"`
+	Graphql                              types.Object  `tfsdk:"graphql"`
+	HmacAllowedAlgorithms                types.List    `tfsdk:"hmac_allowed_algorithms"`
+	HmacAllowedClockSkew                 types.Float64 `tfsdk:"hmac_allowed_clock_skew"`
+	Id                                   types.String  `tfsdk:"id"`
+	IdpClientIdMappingDisabled           types.Bool    `tfsdk:"idp_client_id_mapping_disabled"`
+	Internal                             types.Bool    `tfsdk:"internal"`
+	IsOas                                types.Bool    `tfsdk:"is_oas"`
+	JwtClientBaseField                   types.String  `tfsdk:"jwt_client_base_field"`
+	JwtDefaultPolicies                   types.List    `tfsdk:"jwt_default_policies"`
+	JwtExpiresAtValidationSkew           types.Int64   `tfsdk:"jwt_expires_at_validation_skew"`
+	JwtIdentityBaseField                 types.String  `tfsdk:"jwt_identity_base_field"`
+	JwtIssuedAtValidationSkew            types.Int64   `tfsdk:"jwt_issued_at_validation_skew"`
+	JwtNotBeforeValidationSkew           types.Int64   `tfsdk:"jwt_not_before_validation_skew"`
+	JwtPolicyFieldName                   types.String  `tfsdk:"jwt_policy_field_name"`
+	JwtScopeClaimName                    types.String  `tfsdk:"jwt_scope_claim_name"`
+	JwtScopeToPolicyMapping              types.Map     `tfsdk:"jwt_scope_to_policy_mapping"`
+	JwtSigningMethod                     types.String  `tfsdk:"jwt_signing_method"`
+	JwtSkipKid                           types.Bool    `tfsdk:"jwt_skip_kid"`
+	JwtSource                            types.String  `tfsdk:"jwt_source"`
+	ListenPort                           types.Int64   `tfsdk:"listen_port"`
+	Name                                 types.String  `tfsdk:"name"`
+	Notifications                        types.Object  `tfsdk:"notifications"`
+	OauthMeta                            types.Object  `tfsdk:"oauth_meta"`
+	OpenidOptions                        types.Object  `tfsdk:"openid_options"`
+	OrgId                                types.String  `tfsdk:"org_id"`
+	PinnedPublicKeys                     types.Map     `tfsdk:"pinned_public_keys"`
+	Protocol                             types.String  `tfsdk:"protocol"`
+	Proxy                                types.Object  `tfsdk:"proxy"`
+	RequestSigning                       types.Object  `tfsdk:"request_signing"`
+	ResponseProcessors                   types.List    `tfsdk:"response_processors"`
+	Scopes                               types.Object  `tfsdk:"scopes"`
+	SessionLifetime                      types.Int64   `tfsdk:"session_lifetime"`
+	SessionLifetimeRespectsKeyExpiration types.Bool    `tfsdk:"session_lifetime_respects_key_expiration"`
+	SessionProvider                      types.Object  `tfsdk:"session_provider"`
+	Slug                                 types.String  `tfsdk:"slug"`
+	StripAuthData                        types.Bool    `tfsdk:"strip_auth_data"`
+	TagHeaders                           types.List    `tfsdk:"tag_headers"`
+	Tags                                 types.List    `tfsdk:"tags"`
+	TagsDisabled                         types.Bool    `tfsdk:"tags_disabled"`
+	UpstreamCertificates                 types.Map     `tfsdk:"upstream_certificates"`
+	UpstreamCertificatesDisabled         types.Bool    `tfsdk:"upstream_certificates_disabled"`
+	UptimeTests                          types.Object  `tfsdk:"uptime_tests"`
+	UseBasicAuth                         types.Bool    `tfsdk:"use_basic_auth"`
+	UseGoPluginAuth                      types.Bool    `tfsdk:"use_go_plugin_auth"`
+	UseKeyless                           types.Bool    `tfsdk:"use_keyless"`
+	UseMutualTlsAuth                     types.Bool    `tfsdk:"use_mutual_tls_auth"`
+	UseOauth2                            types.Bool    `tfsdk:"use_oauth2"`
+	UseOpenid                            types.Bool    `tfsdk:"use_openid"`
+	UseStandardAuth                      types.Bool    `tfsdk:"use_standard_auth"`
+	VersionData                          types.Object  `tfsdk:"version_data"`
+}
+
+// ApiDefinitionAnalyticsPluginModel is the model of the analytics_plugin objects.
+type ApiDefinitionAnalyticsPluginModel struct {
+	Enable     types.Bool   `tfsdk:"enable"`
+	FuncName   types.String `tfsdk:"func_name"`
+	PluginPath types.String `tfsdk:"plugin_path"`
+}
+
+// ApiDefinitionAuthModel is the model of the auth objects.
+type ApiDefinitionAuthModel struct {
+	AuthHeaderName    types.String `tfsdk:"auth_header_name"`
+	CookieName        types.String `tfsdk:"cookie_name"`
+	DisableHeader     types.Bool   `tfsdk:"disable_header"`
+	Name              types.String `tfsdk:"name"`
+	ParamName         types.String `tfsdk:"param_name"`
+	Signature         types.Object `tfsdk:"signature"`
+	UseCertificate    types.Bool   `tfsdk:"use_certificate"`
+	UseCookie         types.Bool   `tfsdk:"use_cookie"`
+	UseParam          types.Bool   `tfsdk:"use_param"`
+	ValidateSignature types.Bool   `tfsdk:"validate_signature"`
+}
+
+// ApiDefinitionAuthSignatureModel is the model of the auth.signature objects.
+type ApiDefinitionAuthSignatureModel struct {
+	Algorithm        types.String `tfsdk:"algorithm"`
+	AllowedClockSkew types.Int64  `tfsdk:"allowed_clock_skew"`
+	ErrorCode        types.Int64  `tfsdk:"error_code"`
+	ErrorMessage     types.String `tfsdk:"error_message"`
+	Header           types.String `tfsdk:"header"`
+	ParamName        types.String `tfsdk:"param_name"`
+	Secret           types.String `tfsdk:"secret"`
+	UseParam         types.Bool   `tfsdk:"use_param"`
+}
+
+// ApiDefinitionAuthConfigsModel is the model of the auth_configs objects.
+type ApiDefinitionAuthConfigsModel struct {
+	AuthHeaderName    types.String `tfsdk:"auth_header_name"`
+	CookieName        types.String `tfsdk:"cookie_name"`
+	DisableHeader     types.Bool   `tfsdk:"disable_header"`
+	Name              types.String `tfsdk:"name"`
+	ParamName         types.String `tfsdk:"param_name"`
+	Signature         types.Object `tfsdk:"signature"`
+	UseCertificate    types.Bool   `tfsdk:"use_certificate"`
+	UseCookie         types.Bool   `tfsdk:"use_cookie"`
+	UseParam          types.Bool   `tfsdk:"use_param"`
+	ValidateSignature types.Bool   `tfsdk:"validate_signature"`
+}
+
+// ApiDefinitionAuthConfigsSignatureModel is the model of the auth_configs.signature objects.
+type ApiDefinitionAuthConfigsSignatureModel struct {
+	Algorithm        types.String `tfsdk:"algorithm"`
+	AllowedClockSkew types.Int64  `tfsdk:"allowed_clock_skew"`
+	ErrorCode        types.Int64  `tfsdk:"error_code"`
+	ErrorMessage     types.String `tfsdk:"error_message"`
+	Header           types.String `tfsdk:"header"`
+	ParamName        types.String `tfsdk:"param_name"`
+	Secret           types.String `tfsdk:"secret"`
+	UseParam         types.Bool   `tfsdk:"use_param"`
+}
+
+// ApiDefinitionAuthProviderModel is the model of the auth_provider objects.
+type ApiDefinitionAuthProviderModel struct {
+	Name          types.String `tfsdk:"name"`
+	StorageEngine types.String `tfsdk:"storage_engine"`
+}
+
+// ApiDefinitionBasicAuthModel is the model of the basic_auth objects.
+type ApiDefinitionBasicAuthModel struct {
+	BodyPasswordRegexp types.String `tfsdk:"body_password_regexp"`
+	BodyUserRegexp     types.String `tfsdk:"body_user_regexp"`
+	CacheTtl           types.Int64  `tfsdk:"cache_ttl"`
+	DisableCaching     types.Bool   `tfsdk:"disable_caching"`
+	ExtractFromBody    types.Bool   `tfsdk:"extract_from_body"`
+}
+
+// ApiDefinitionCacheOptionsModel is the model of the cache_options objects.
+type ApiDefinitionCacheOptionsModel struct {
+	CacheAllSafeRequests       types.Bool   `tfsdk:"cache_all_safe_requests"`
+	CacheByHeaders             types.List   `tfsdk:"cache_by_headers"`
+	CacheControlTtlHeader      types.String `tfsdk:"cache_control_ttl_header"`
+	CacheResponseCodes         types.List   `tfsdk:"cache_response_codes"`
+	CacheTimeout               types.Int64  `tfsdk:"cache_timeout"`
+	EnableCache                types.Bool   `tfsdk:"enable_cache"`
+	EnableUpstreamCacheControl types.Bool   `tfsdk:"enable_upstream_cache_control"`
+}
+
+// ApiDefinitionCorsModel is the model of the cors objects.
+type ApiDefinitionCorsModel struct {
+	AllowCredentials   types.Bool  `tfsdk:"allow_credentials"`
+	AllowedHeaders     types.List  `tfsdk:"allowed_headers"`
+	AllowedMethods     types.List  `tfsdk:"allowed_methods"`
+	AllowedOrigins     types.List  `tfsdk:"allowed_origins"`
+	Debug              types.Bool  `tfsdk:"debug"`
+	Enable             types.Bool  `tfsdk:"enable"`
+	ExposedHeaders     types.List  `tfsdk:"exposed_headers"`
+	MaxAge             types.Int64 `tfsdk:"max_age"`
+	OptionsPassthrough types.Bool  `tfsdk:"options_passthrough"`
+}
+
+// ApiDefinitionCustomMiddlewareModel is the model of the custom_middleware objects.
+type ApiDefinitionCustomMiddlewareModel struct {
+	AuthCheck   types.Object `tfsdk:"auth_check"`
+	Driver      types.String `tfsdk:"driver"`
+	IdExtractor types.Object `tfsdk:"id_extractor"`
+	Post        types.List   `tfsdk:"post"`
+	PostKeyAuth types.List   `tfsdk:"post_key_auth"`
+	Pre         types.List   `tfsdk:"pre"`
+	Response    types.List   `tfsdk:"response"`
+}
+
+// ApiDefinitionCustomMiddlewareAuthCheckModel is the model of the custom_middleware.auth_check objects.
+type ApiDefinitionCustomMiddlewareAuthCheckModel struct {
+	Disabled       types.Bool   `tfsdk:"disabled"`
+	Name           types.String `tfsdk:"name"`
+	Path           types.String `tfsdk:"path"`
+	RawBodyOnly    types.Bool   `tfsdk:"raw_body_only"`
+	RequireSession types.Bool   `tfsdk:"require_session"`
+}
+
+// ApiDefinitionCustomMiddlewareIdExtractorModel is the model of the custom_middleware.id_extractor objects.
+type ApiDefinitionCustomMiddlewareIdExtractorModel struct {
+	Disabled    types.Bool   `tfsdk:"disabled"`
+	ExtractFrom types.String `tfsdk:"extract_from"`
+	ExtractWith types.String `tfsdk:"extract_with"`
+}
+
+// ApiDefinitionCustomMiddlewarePostModel is the model of the custom_middleware.post objects.
+type ApiDefinitionCustomMiddlewarePostModel struct {
+	Disabled       types.Bool   `tfsdk:"disabled"`
+	Name           types.String `tfsdk:"name"`
+	Path           types.String `tfsdk:"path"`
+	RawBodyOnly    types.Bool   `tfsdk:"raw_body_only"`
+	RequireSession types.Bool   `tfsdk:"require_session"`
+}
+
+// ApiDefinitionCustomMiddlewarePostKeyAuthModel is the model of the custom_middleware.post_key_auth objects.
+type ApiDefinitionCustomMiddlewarePostKeyAuthModel struct {
+	Disabled       types.Bool   `tfsdk:"disabled"`
+	Name           types.String `tfsdk:"name"`
+	Path           types.String `tfsdk:"path"`
+	RawBodyOnly    types.Bool   `tfsdk:"raw_body_only"`
+	RequireSession types.Bool   `tfsdk:"require_session"`
+}
+
+// ApiDefinitionCustomMiddlewarePreModel is the model of the custom_middleware.pre objects.
+type ApiDefinitionCustomMiddlewarePreModel struct {
+	Disabled       types.Bool   `tfsdk:"disabled"`
+	Name           types.String `tfsdk:"name"`
+	Path           types.String `tfsdk:"path"`
+	RawBodyOnly    types.Bool   `tfsdk:"raw_body_only"`
+	RequireSession types.Bool   `tfsdk:"require_session"`
+}
+
+// ApiDefinitionCustomMiddlewareResponseModel is the model of the custom_middleware.response objects.
+type ApiDefinitionCustomMiddlewareResponseModel struct {
+	Disabled       types.Bool   `tfsdk:"disabled"`
+	Name           types.String `tfsdk:"name"`
+	Path           types.String `tfsdk:"path"`
+	RawBodyOnly    types.Bool   `tfsdk:"raw_body_only"`
+	RequireSession types.Bool   `tfsdk:"require_session"`
+}
+
+// ApiDefinitionDefinitionModel is the model of the definition objects.
+type ApiDefinitionDefinitionModel struct {
+	Default              types.String `tfsdk:"default"`
+	Enabled              types.Bool   `tfsdk:"enabled"`
+	FallbackToDefault    types.Bool   `tfsdk:"fallback_to_default"`
+	Key                  types.String `tfsdk:"key"`
+	Location             types.String `tfsdk:"location"`
+	Name                 types.String `tfsdk:"name"`
+	StripPath            types.Bool   `tfsdk:"strip_path"`
+	StripVersioningData  types.Bool   `tfsdk:"strip_versioning_data"`
+	UrlVersioningPattern types.String `tfsdk:"url_versioning_pattern"`
+	Versions             types.Map    `tfsdk:"versions"`
+}
+
+// ApiDefinitionEventHandlersModel is the model of the event_handlers objects.
+type ApiDefinitionEventHandlersModel struct {
+	Events types.Map `tfsdk:"events"`
+}
+
+// ApiDefinitionExternalOauthModel is the model of the external_oauth objects.
+type ApiDefinitionExternalOauthModel struct {
+	Enabled   types.Bool `tfsdk:"enabled"`
+	Providers types.List `tfsdk:"providers"`
+}
+
+// ApiDefinitionExternalOauthProvidersModel is the model of the external_oauth.providers objects.
+type ApiDefinitionExternalOauthProvidersModel struct {
+	Introspection types.Object `tfsdk:"introspection"`
+	Jwt           types.Object `tfsdk:"jwt"`
+}
+
+// ApiDefinitionExternalOauthProvidersIntrospectionModel is the model of the external_oauth.providers.introspection objects.
+type ApiDefinitionExternalOauthProvidersIntrospectionModel struct {
+	Cache             types.Object `tfsdk:"cache"`
+	ClientId          types.String `tfsdk:"client_id"`
+	ClientSecret      types.String `tfsdk:"client_secret"`
+	Enabled           types.Bool   `tfsdk:"enabled"`
+	IdentityBaseField types.String `tfsdk:"identity_base_field"`
+	Url               types.String `tfsdk:"url"`
+}
+
+// ApiDefinitionExternalOauthProvidersIntrospectionCacheModel is the model of the external_oauth.providers.introspection.cache objects.
+type ApiDefinitionExternalOauthProvidersIntrospectionCacheModel struct {
+	Enabled types.Bool  `tfsdk:"enabled"`
+	Timeout types.Int64 `tfsdk:"timeout"`
+}
+
+// ApiDefinitionExternalOauthProvidersJwtModel is the model of the external_oauth.providers.jwt objects.
+type ApiDefinitionExternalOauthProvidersJwtModel struct {
+	Enabled                 types.Bool   `tfsdk:"enabled"`
+	ExpiresAtValidationSkew types.Int64  `tfsdk:"expires_at_validation_skew"`
+	IdentityBaseField       types.String `tfsdk:"identity_base_field"`
+	IssuedAtValidationSkew  types.Int64  `tfsdk:"issued_at_validation_skew"`
+	NotBeforeValidationSkew types.Int64  `tfsdk:"not_before_validation_skew"`
+	SigningMethod           types.String `tfsdk:"signing_method"`
+	Source                  types.String `tfsdk:"source"`
+}
+
+// ApiDefinitionGlobalRateLimitModel is the model of the global_rate_limit objects.
+type ApiDefinitionGlobalRateLimitModel struct {
+	Disabled types.Bool    `tfsdk:"disabled"`
+	Per      types.Float64 `tfsdk:"per"`
+	Rate     types.Float64 `tfsdk:"rate"`
+}
+
+// ApiDefinitionGraphqlModel is the model of the graphql objects.
+type ApiDefinitionGraphqlModel struct {
+	Enabled                 types.Bool   `tfsdk:"enabled"`
+	Engine                  types.Object `tfsdk:"engine"`
+	ExecutionMode           types.String `tfsdk:"execution_mode"`
+	Introspection           types.Object `tfsdk:"introspection"`
+	LastSchemaUpdate        types.String `tfsdk:"last_schema_update"`
+	Playground              types.Object `tfsdk:"playground"`
+	Proxy                   types.Object `tfsdk:"proxy"`
+	Schema                  types.String `tfsdk:"schema"`
+	Subgraph                types.Object `tfsdk:"subgraph"`
+	Supergraph              types.Object `tfsdk:"supergraph"`
+	TypeFieldConfigurations types.List   `tfsdk:"type_field_configurations"`
+	Version                 types.String `tfsdk:"version"`
+}
+
+// ApiDefinitionGraphqlEngineModel is the model of the graphql.engine objects.
+type ApiDefinitionGraphqlEngineModel struct {
+	DataSources   types.List `tfsdk:"data_sources"`
+	FieldConfigs  types.List `tfsdk:"field_configs"`
+	GlobalHeaders types.List `tfsdk:"global_headers"`
+}
+
+// ApiDefinitionGraphqlEngineDataSourcesModel is the model of the graphql.engine.data_sources objects.
+type ApiDefinitionGraphqlEngineDataSourcesModel struct {
+	Internal   types.Bool   `tfsdk:"internal"`
+	Kind       types.String `tfsdk:"kind"`
+	Name       types.String `tfsdk:"name"`
+	RootFields types.List   `tfsdk:"root_fields"`
+}
+
+// ApiDefinitionGraphqlEngineDataSourcesRootFieldsModel is the model of the graphql.engine.data_sources.root_fields objects.
+type ApiDefinitionGraphqlEngineDataSourcesRootFieldsModel struct {
+	Fields types.List   `tfsdk:"fields"`
+	Type   types.String `tfsdk:"type"`
+}
+
+// ApiDefinitionGraphqlEngineFieldConfigsModel is the model of the graphql.engine.field_configs objects.
+type ApiDefinitionGraphqlEngineFieldConfigsModel struct {
+	DisableDefaultMapping types.Bool   `tfsdk:"disable_default_mapping"`
+	FieldName             types.String `tfsdk:"field_name"`
+	Path                  types.List   `tfsdk:"path"`
+	TypeName              types.String `tfsdk:"type_name"`
+}
+
+// ApiDefinitionGraphqlEngineGlobalHeadersModel is the model of the graphql.engine.global_headers objects.
+type ApiDefinitionGraphqlEngineGlobalHeadersModel struct {
+	Key   types.String `tfsdk:"key"`
+	Value types.String `tfsdk:"value"`
+}
+
+// ApiDefinitionGraphqlIntrospectionModel is the model of the graphql.introspection objects.
+type ApiDefinitionGraphqlIntrospectionModel struct {
+	Disabled types.Bool `tfsdk:"disabled"`
+}
+
+// ApiDefinitionGraphqlPlaygroundModel is the model of the graphql.playground objects.
+type ApiDefinitionGraphqlPlaygroundModel struct {
+	Enabled types.Bool   `tfsdk:"enabled"`
+	Path    types.String `tfsdk:"path"`
+}
+
+// ApiDefinitionGraphqlProxyModel is the model of the graphql.proxy objects.
+type ApiDefinitionGraphqlProxyModel struct {
+	AuthHeaders           types.Map    `tfsdk:"auth_headers"`
+	Features              types.Object `tfsdk:"features"`
+	RequestHeaders        types.Map    `tfsdk:"request_headers"`
+	RequestHeadersRewrite types.Map    `tfsdk:"request_headers_rewrite"`
+	SubscriptionType      types.String `tfsdk:"subscription_type"`
+	UseResponseExtensions types.Object `tfsdk:"use_response_extensions"`
+}
+
+// ApiDefinitionGraphqlProxyFeaturesModel is the model of the graphql.proxy.features objects.
+type ApiDefinitionGraphqlProxyFeaturesModel struct {
+	UseImmutableHeaders types.Bool `tfsdk:"use_immutable_headers"`
+}
+
+// ApiDefinitionGraphqlProxyRequestHeadersRewriteModel is the model of the graphql.proxy.request_headers_rewrite objects.
+type ApiDefinitionGraphqlProxyRequestHeadersRewriteModel struct {
+	Remove types.Bool   `tfsdk:"remove"`
+	Value  types.String `tfsdk:"value"`
+}
+
+// ApiDefinitionGraphqlProxyUseResponseExtensionsModel is the model of the graphql.proxy.use_response_extensions objects.
+type ApiDefinitionGraphqlProxyUseResponseExtensionsModel struct {
+	OnErrorForwarding types.Bool `tfsdk:"on_error_forwarding"`
+}
+
+// ApiDefinitionGraphqlSubgraphModel is the model of the graphql.subgraph objects.
+type ApiDefinitionGraphqlSubgraphModel struct {
+	Sdl types.String `tfsdk:"sdl"`
+}
+
+// ApiDefinitionGraphqlSupergraphModel is the model of the graphql.supergraph objects.
+type ApiDefinitionGraphqlSupergraphModel struct {
+	DisableQueryBatching types.Bool   `tfsdk:"disable_query_batching"`
+	GlobalHeaders        types.Map    `tfsdk:"global_headers"`
+	MergedSdl            types.String `tfsdk:"merged_sdl"`
+	Subgraphs            types.List   `tfsdk:"subgraphs"`
+	UpdatedAt            types.String `tfsdk:"updated_at"`
+}
+
+// ApiDefinitionGraphqlSupergraphSubgraphsModel is the model of the graphql.supergraph.subgraphs objects.
+type ApiDefinitionGraphqlSupergraphSubgraphsModel struct {
+	ApiId            types.String `tfsdk:"api_id"`
+	Headers          types.Map    `tfsdk:"headers"`
+	Name             types.String `tfsdk:"name"`
+	Sdl              types.String `tfsdk:"sdl"`
+	SubscriptionType types.String `tfsdk:"subscription_type"`
+	Url              types.String `tfsdk:"url"`
+}
+
+// ApiDefinitionGraphqlTypeFieldConfigurationsModel is the model of the graphql.type_field_configurations objects.
+type ApiDefinitionGraphqlTypeFieldConfigurationsModel struct {
+	DataSource types.Object `tfsdk:"data_source"`
+	FieldName  types.String `tfsdk:"field_name"`
+	Mapping    types.Object `tfsdk:"mapping"`
+	TypeName   types.String `tfsdk:"type_name"`
+}
+
+// ApiDefinitionGraphqlTypeFieldConfigurationsDataSourceModel is the model of the graphql.type_field_configurations.data_source objects.
+type ApiDefinitionGraphqlTypeFieldConfigurationsDataSourceModel struct {
+	Kind types.String `tfsdk:"kind"`
+}
+
+// ApiDefinitionGraphqlTypeFieldConfigurationsMappingModel is the model of the graphql.type_field_configurations.mapping objects.
+type ApiDefinitionGraphqlTypeFieldConfigurationsMappingModel struct {
+	Disabled types.Bool   `tfsdk:"disabled"`
+	Path     types.String `tfsdk:"path"`
+}
+
+// ApiDefinitionNotificationsModel is the model of the notifications objects.
+type ApiDefinitionNotificationsModel struct {
+	OauthOnKeychangeUrl types.String `tfsdk:"oauth_on_keychange_url"`
+	SharedSecret        types.String `tfsdk:"shared_secret"`
+}
+
+// ApiDefinitionOauthMetaModel is the model of the oauth_meta objects.
+type ApiDefinitionOauthMetaModel struct {
+	AllowedAccessTypes    types.List   `tfsdk:"allowed_access_types"`
+	AllowedAuthorizeTypes types.List   `tfsdk:"allowed_authorize_types"`
+	AuthLoginRedirect     types.String `tfsdk:"auth_login_redirect"`
+}
+
+// ApiDefinitionOpenidOptionsModel is the model of the openid_options objects.
+type ApiDefinitionOpenidOptionsModel struct {
+	Providers         types.List `tfsdk:"providers"`
+	SegregateByClient types.Bool `tfsdk:"segregate_by_client"`
+}
+
+// ApiDefinitionOpenidOptionsProvidersModel is the model of the openid_options.providers objects.
+type ApiDefinitionOpenidOptionsProvidersModel struct {
+	ClientIds types.Map    `tfsdk:"client_ids"`
+	Issuer    types.String `tfsdk:"issuer"`
+}
+
+// ApiDefinitionProxyModel is the model of the proxy objects.
+type ApiDefinitionProxyModel struct {
+	CheckHostAgainstUptimeTests types.Bool   `tfsdk:"check_host_against_uptime_tests"`
+	DisableStripSlash           types.Bool   `tfsdk:"disable_strip_slash"`
+	EnableLoadBalancing         types.Bool   `tfsdk:"enable_load_balancing"`
+	ListenPath                  types.String `tfsdk:"listen_path"`
+	PreserveHostHeader          types.Bool   `tfsdk:"preserve_host_header"`
+	ServiceDiscovery            types.Object `tfsdk:"service_discovery"`
+	StripListenPath             types.Bool   `tfsdk:"strip_listen_path"`
+	TargetList                  types.List   `tfsdk:"target_list"`
+	TargetUrl                   types.String `tfsdk:"target_url"`
+	Transport                   types.Object `tfsdk:"transport"`
+}
+
+// ApiDefinitionProxyServiceDiscoveryModel is the model of the proxy.service_discovery objects.
+type ApiDefinitionProxyServiceDiscoveryModel struct {
+	CacheDisabled       types.Bool   `tfsdk:"cache_disabled"`
+	CacheTimeout        types.Int64  `tfsdk:"cache_timeout"`
+	DataPath            types.String `tfsdk:"data_path"`
+	EndpointReturnsList types.Bool   `tfsdk:"endpoint_returns_list"`
+	ParentDataPath      types.String `tfsdk:"parent_data_path"`
+	PortDataPath        types.String `tfsdk:"port_data_path"`
+	QueryEndpoint       types.String `tfsdk:"query_endpoint"`
+	TargetPath          types.String `tfsdk:"target_path"`
+	UseDiscoveryService types.Bool   `tfsdk:"use_discovery_service"`
+	UseNestedQuery      types.Bool   `tfsdk:"use_nested_query"`
+	UseTargetList       types.Bool   `tfsdk:"use_target_list"`
+}
+
+// ApiDefinitionProxyTransportModel is the model of the proxy.transport objects.
+type ApiDefinitionProxyTransportModel struct {
+	ProxyUrl                types.String `tfsdk:"proxy_url"`
+	SslCiphers              types.List   `tfsdk:"ssl_ciphers"`
+	SslForceCommonNameCheck types.Bool   `tfsdk:"ssl_force_common_name_check"`
+	SslInsecureSkipVerify   types.Bool   `tfsdk:"ssl_insecure_skip_verify"`
+	SslMaxVersion           types.Int64  `tfsdk:"ssl_max_version"`
+	SslMinVersion           types.Int64  `tfsdk:"ssl_min_version"`
+}
+
+// ApiDefinitionRequestSigningModel is the model of the request_signing objects.
+type ApiDefinitionRequestSigningModel struct {
+	Algorithm       types.String `tfsdk:"algorithm"`
+	CertificateId   types.String `tfsdk:"certificate_id"`
+	HeaderList      types.List   `tfsdk:"header_list"`
+	IsEnabled       types.Bool   `tfsdk:"is_enabled"`
+	KeyId           types.String `tfsdk:"key_id"`
+	Secret          types.String `tfsdk:"secret"`
+	SignatureHeader types.String `tfsdk:"signature_header"`
+}
+
+// ApiDefinitionResponseProcessorsModel is the model of the response_processors objects.
+type ApiDefinitionResponseProcessorsModel struct {
+	Name types.String `tfsdk:"name"`
+}
+
+// ApiDefinitionScopesModel is the model of the scopes objects.
+type ApiDefinitionScopesModel struct {
+	Jwt  types.Object `tfsdk:"jwt"`
+	Oidc types.Object `tfsdk:"oidc"`
+}
+
+// ApiDefinitionScopesJwtModel is the model of the scopes.jwt objects.
+type ApiDefinitionScopesJwtModel struct {
+	ScopeClaimName types.String `tfsdk:"scope_claim_name"`
+	ScopeToPolicy  types.Map    `tfsdk:"scope_to_policy"`
+}
+
+// ApiDefinitionScopesOidcModel is the model of the scopes.oidc objects.
+type ApiDefinitionScopesOidcModel struct {
+	ScopeClaimName types.String `tfsdk:"scope_claim_name"`
+	ScopeToPolicy  types.Map    `tfsdk:"scope_to_policy"`
+}
+
+// ApiDefinitionSessionProviderModel is the model of the session_provider objects.
+type ApiDefinitionSessionProviderModel struct {
+	Name          types.String `tfsdk:"name"`
+	StorageEngine types.String `tfsdk:"storage_engine"`
+}
+
+// ApiDefinitionUptimeTestsModel is the model of the uptime_tests objects.
+type ApiDefinitionUptimeTestsModel struct {
+	CheckList types.List   `tfsdk:"check_list"`
+	Config    types.Object `tfsdk:"config"`
+}
+
+// ApiDefinitionUptimeTestsCheckListModel is the model of the uptime_tests.check_list objects.
+type ApiDefinitionUptimeTestsCheckListModel struct {
+	Body                types.String `tfsdk:"body"`
+	Commands            types.List   `tfsdk:"commands"`
+	EnableProxyProtocol types.Bool   `tfsdk:"enable_proxy_protocol"`
+	Headers             types.Map    `tfsdk:"headers"`
+	Method              types.String `tfsdk:"method"`
+	Protocol            types.String `tfsdk:"protocol"`
+	Timeout             types.Int64  `tfsdk:"timeout"`
+	Url                 types.String `tfsdk:"url"`
+}
+
+// ApiDefinitionUptimeTestsCheckListCommandsModel is the model of the uptime_tests.check_list.commands objects.
+type ApiDefinitionUptimeTestsCheckListCommandsModel struct {
+	Message types.String `tfsdk:"message"`
+	Name    types.String `tfsdk:"name"`
+}
+
+// ApiDefinitionUptimeTestsConfigModel is the model of the uptime_tests.config objects.
+type ApiDefinitionUptimeTestsConfigModel struct {
+	ExpireUtimeAfter types.Int64  `tfsdk:"expire_utime_after"`
+	RecheckWait      types.Int64  `tfsdk:"recheck_wait"`
+	ServiceDiscovery types.Object `tfsdk:"service_discovery"`
+}
+
+// ApiDefinitionUptimeTestsConfigServiceDiscoveryModel is the model of the uptime_tests.config.service_discovery objects.
+type ApiDefinitionUptimeTestsConfigServiceDiscoveryModel struct {
+	CacheDisabled       types.Bool   `tfsdk:"cache_disabled"`
+	CacheTimeout        types.Int64  `tfsdk:"cache_timeout"`
+	DataPath            types.String `tfsdk:"data_path"`
+	EndpointReturnsList types.Bool   `tfsdk:"endpoint_returns_list"`
+	ParentDataPath      types.String `tfsdk:"parent_data_path"`
+	PortDataPath        types.String `tfsdk:"port_data_path"`
+	QueryEndpoint       types.String `tfsdk:"query_endpoint"`
+	TargetPath          types.String `tfsdk:"target_path"`
+	UseDiscoveryService types.Bool   `tfsdk:"use_discovery_service"`
+	UseNestedQuery      types.Bool   `tfsdk:"use_nested_query"`
+	UseTargetList       types.Bool   `tfsdk:"use_target_list"`
+}
+
+// ApiDefinitionVersionDataModel is the model of the version_data objects.
+type ApiDefinitionVersionDataModel struct {
+	DefaultVersion types.String `tfsdk:"default_version"`
+	NotVersioned   types.Bool   `tfsdk:"not_versioned"`
+	Versions       types.Map    `tfsdk:"versions"`
+}
+
+// ApiDefinitionVersionDataVersionsModel is the model of the version_data.versions objects.
+type ApiDefinitionVersionDataVersionsModel struct {
+	Expires                       types.String `tfsdk:"expires"`
+	ExtendedPaths                 types.Object `tfsdk:"extended_paths"`
+	GlobalHeaders                 types.Map    `tfsdk:"global_headers"`
+	GlobalHeadersDisabled         types.Bool   `tfsdk:"global_headers_disabled"`
+	GlobalHeadersRemove           types.List   `tfsdk:"global_headers_remove"`
+	GlobalResponseHeaders         types.Map    `tfsdk:"global_response_headers"`
+	GlobalResponseHeadersDisabled types.Bool   `tfsdk:"global_response_headers_disabled"`
+	GlobalResponseHeadersRemove   types.List   `tfsdk:"global_response_headers_remove"`
+	GlobalSizeLimit               types.Int64  `tfsdk:"global_size_limit"`
+	IgnoreEndpointCase            types.Bool   `tfsdk:"ignore_endpoint_case"`
+	Name                          types.String `tfsdk:"name"`
+	OverrideTarget                types.String `tfsdk:"override_target"`
+	Paths                         types.Object `tfsdk:"paths"`
+	UseExtendedPaths              types.Bool   `tfsdk:"use_extended_paths"`
+}
+
+// ApiDefinitionVersionDataVersionsExtendedPathsModel is the model of the version_data.versions.extended_paths objects.
+type ApiDefinitionVersionDataVersionsExtendedPathsModel struct {
+	AdvanceCacheConfig       types.List `tfsdk:"advance_cache_config"`
+	BlackList                types.List `tfsdk:"black_list"`
+	Cache                    types.List `tfsdk:"cache"`
+	CircuitBreakers          types.List `tfsdk:"circuit_breakers"`
+	DoNotTrackEndpoints      types.List `tfsdk:"do_not_track_endpoints"`
+	GoPlugin                 types.List `tfsdk:"go_plugin"`
+	HardTimeouts             types.List `tfsdk:"hard_timeouts"`
+	Ignored                  types.List `tfsdk:"ignored"`
+	Internal                 types.List `tfsdk:"internal"`
+	MethodTransforms         types.List `tfsdk:"method_transforms"`
+	MockResponse             types.List `tfsdk:"mock_response"`
+	PersistGraphql           types.List `tfsdk:"persist_graphql"`
+	RateLimit                types.List `tfsdk:"rate_limit"`
+	SizeLimits               types.List `tfsdk:"size_limits"`
+	TrackEndpoints           types.List `tfsdk:"track_endpoints"`
+	Transform                types.List `tfsdk:"transform"`
+	TransformHeaders         types.List `tfsdk:"transform_headers"`
+	TransformJq              types.List `tfsdk:"transform_jq"`
+	TransformJqResponse      types.List `tfsdk:"transform_jq_response"`
+	TransformResponse        types.List `tfsdk:"transform_response"`
+	TransformResponseHeaders types.List `tfsdk:"transform_response_headers"`
+	UrlRewrites              types.List `tfsdk:"url_rewrites"`
+	ValidateJson             types.List `tfsdk:"validate_json"`
+	ValidateRequest          types.List `tfsdk:"validate_request"`
+	Virtual                  types.List `tfsdk:"virtual"`
+	WhiteList                types.List `tfsdk:"white_list"`
+}
+
+// ApiDefinitionVersionDataVersionsExtendedPathsAdvanceCacheConfigModel is the model of the version_data.versions.extended_paths.advance_cache_config objects.
+type ApiDefinitionVersionDataVersionsExtendedPathsAdvanceCacheConfigModel struct {
+	CacheKeyRegex      types.String `tfsdk:"cache_key_regex"`
+	CacheResponseCodes types.List   `tfsdk:"cache_response_codes"`
+	Disabled           types.Bool   `tfsdk:"disabled"`
+	Method             types.String `tfsdk:"method"`
+	Path               types.String `tfsdk:"path"`
+	Timeout            types.Int64  `tfsdk:"timeout"`
+}
+
+// ApiDefinitionVersionDataVersionsExtendedPathsBlackListModel is the model of the version_data.versions.extended_paths.black_list objects.
+type ApiDefinitionVersionDataVersionsExtendedPathsBlackListModel struct {
+	Disabled      types.Bool   `tfsdk:"disabled"`
+	IgnoreCase    types.Bool   `tfsdk:"ignore_case"`
+	Method        types.String `tfsdk:"method"`
+	MethodActions types.Map    `tfsdk:"method_actions"`
+	Path          types.String `tfsdk:"path"`
+}
+
+// ApiDefinitionVersionDataVersionsExtendedPathsBlackListMethodActionsModel is the model of the version_data.versions.extended_paths.black_list.method_actions objects.
+type ApiDefinitionVersionDataVersionsExtendedPathsBlackListMethodActionsModel struct {
+	Action  types.String `tfsdk:"action"`
+	Code    types.Int64  `tfsdk:"code"`
+	Data    types.String `tfsdk:"data"`
+	Headers types.Map    `tfsdk:"headers"`
+}
+
+// ApiDefinitionVersionDataVersionsExtendedPathsCircuitBreakersModel is the model of the version_data.versions.extended_paths.circuit_breakers objects.
+type ApiDefinitionVersionDataVersionsExtendedPathsCircuitBreakersModel struct {
+	DisableHalfOpenState types.Bool    `tfsdk:"disable_half_open_state"`
+	Disabled             types.Bool    `tfsdk:"disabled"`
+	Method               types.String  `tfsdk:"method"`
+	Path                 types.String  `tfsdk:"path"`
+	ReturnToServiceAfter types.Int64   `tfsdk:"return_to_service_after"`
+	Samples              types.Int64   `tfsdk:"samples"`
+	ThresholdPercent     types.Float64 `tfsdk:"threshold_percent"`
+}
+
+// ApiDefinitionVersionDataVersionsExtendedPathsDoNotTrackEndpointsModel is the model of the version_data.versions.extended_paths.do_not_track_endpoints objects.
+type ApiDefinitionVersionDataVersionsExtendedPathsDoNotTrackEndpointsModel struct {
+	Disabled types.Bool   `tfsdk:"disabled"`
+	Method   types.String `tfsdk:"method"`
+	Path     types.String `tfsdk:"path"`
+}
+
+// ApiDefinitionVersionDataVersionsExtendedPathsGoPluginModel is the model of the version_data.versions.extended_paths.go_plugin objects.
+type ApiDefinitionVersionDataVersionsExtendedPathsGoPluginModel struct {
+	Disabled   types.Bool   `tfsdk:"disabled"`
+	FuncName   types.String `tfsdk:"func_name"`
+	Method     types.String `tfsdk:"method"`
+	Path       types.String `tfsdk:"path"`
+	PluginPath types.String `tfsdk:"plugin_path"`
+}
+
+// ApiDefinitionVersionDataVersionsExtendedPathsHardTimeoutsModel is the model of the version_data.versions.extended_paths.hard_timeouts objects.
+type ApiDefinitionVersionDataVersionsExtendedPathsHardTimeoutsModel struct {
+	Disabled types.Bool   `tfsdk:"disabled"`
+	Method   types.String `tfsdk:"method"`
+	Path     types.String `tfsdk:"path"`
+	Timeout  types.Int64  `tfsdk:"timeout"`
+}
+
+// ApiDefinitionVersionDataVersionsExtendedPathsIgnoredModel is the model of the version_data.versions.extended_paths.ignored objects.
+type ApiDefinitionVersionDataVersionsExtendedPathsIgnoredModel struct {
+	Disabled      types.Bool   `tfsdk:"disabled"`
+	IgnoreCase    types.Bool   `tfsdk:"ignore_case"`
+	Method        types.String `tfsdk:"method"`
+	MethodActions types.Map    `tfsdk:"method_actions"`
+	Path          types.String `tfsdk:"path"`
+}
+
+// ApiDefinitionVersionDataVersionsExtendedPathsIgnoredMethodActionsModel is the model of the version_data.versions.extended_paths.ignored.method_actions objects.
+type ApiDefinitionVersionDataVersionsExtendedPathsIgnoredMethodActionsModel struct {
+	Action  types.String `tfsdk:"action"`
+	Code    types.Int64  `tfsdk:"code"`
+	Data    types.String `tfsdk:"data"`
+	Headers types.Map    `tfsdk:"headers"`
+}
+
+// ApiDefinitionVersionDataVersionsExtendedPathsInternalModel is the model of the version_data.versions.extended_paths.internal objects.
+type ApiDefinitionVersionDataVersionsExtendedPathsInternalModel struct {
+	Disabled types.Bool   `tfsdk:"disabled"`
+	Method   types.String `tfsdk:"method"`
+	Path     types.String `tfsdk:"path"`
+}
+
+// ApiDefinitionVersionDataVersionsExtendedPathsMethodTransformsModel is the model of the version_data.versions.extended_paths.method_transforms objects.
+type ApiDefinitionVersionDataVersionsExtendedPathsMethodTransformsModel struct {
+	Disabled types.Bool   `tfsdk:"disabled"`
+	Method   types.String `tfsdk:"method"`
+	Path     types.String `tfsdk:"path"`
+	ToMethod types.String `tfsdk:"to_method"`
+}
+
+// ApiDefinitionVersionDataVersionsExtendedPathsMockResponseModel is the model of the version_data.versions.extended_paths.mock_response objects.
+type ApiDefinitionVersionDataVersionsExtendedPathsMockResponseModel struct {
+	Body       types.String `tfsdk:"body"`
+	Code       types.Int64  `tfsdk:"code"`
+	Disabled   types.Bool   `tfsdk:"disabled"`
+	Headers    types.Map    `tfsdk:"headers"`
+	IgnoreCase types.Bool   `tfsdk:"ignore_case"`
+	Method     types.String `tfsdk:"method"`
+	Path       types.String `tfsdk:"path"`
+}
+
+// ApiDefinitionVersionDataVersionsExtendedPathsPersistGraphqlModel is the model of the version_data.versions.extended_paths.persist_graphql objects.
+type ApiDefinitionVersionDataVersionsExtendedPathsPersistGraphqlModel struct {
+	Method    types.String `tfsdk:"method"`
+	Operation types.String `tfsdk:"operation"`
+	Path      types.String `tfsdk:"path"`
+}
+
+// ApiDefinitionVersionDataVersionsExtendedPathsRateLimitModel is the model of the version_data.versions.extended_paths.rate_limit objects.
+type ApiDefinitionVersionDataVersionsExtendedPathsRateLimitModel struct {
+	Disabled types.Bool    `tfsdk:"disabled"`
+	Method   types.String  `tfsdk:"method"`
+	Path     types.String  `tfsdk:"path"`
+	Per      types.Float64 `tfsdk:"per"`
+	Rate     types.Float64 `tfsdk:"rate"`
+}
+
+// ApiDefinitionVersionDataVersionsExtendedPathsSizeLimitsModel is the model of the version_data.versions.extended_paths.size_limits objects.
+type ApiDefinitionVersionDataVersionsExtendedPathsSizeLimitsModel struct {
+	Disabled  types.Bool   `tfsdk:"disabled"`
+	Method    types.String `tfsdk:"method"`
+	Path      types.String `tfsdk:"path"`
+	SizeLimit types.Int64  `tfsdk:"size_limit"`
+}
+
+// ApiDefinitionVersionDataVersionsExtendedPathsTrackEndpointsModel is the model of the version_data.versions.extended_paths.track_endpoints objects.
+type ApiDefinitionVersionDataVersionsExtendedPathsTrackEndpointsModel struct {
+	Disabled types.Bool   `tfsdk:"disabled"`
+	Method   types.String `tfsdk:"method"`
+	Path     types.String `tfsdk:"path"`
+}
+
+// ApiDefinitionVersionDataVersionsExtendedPathsTransformModel is the model of the version_data.versions.extended_paths.transform objects.
+type ApiDefinitionVersionDataVersionsExtendedPathsTransformModel struct {
+	Disabled     types.Bool   `tfsdk:"disabled"`
+	Method       types.String `tfsdk:"method"`
+	Path         types.String `tfsdk:"path"`
+	TemplateData types.Object `tfsdk:"template_data"`
+}
+
+// ApiDefinitionVersionDataVersionsExtendedPathsTransformTemplateDataModel is the model of the version_data.versions.extended_paths.transform.template_data objects.
+type ApiDefinitionVersionDataVersionsExtendedPathsTransformTemplateDataModel struct {
+	EnableSession  types.Bool   `tfsdk:"enable_session"`
+	InputType      types.String `tfsdk:"input_type"`
+	TemplateMode   types.String `tfsdk:"template_mode"`
+	TemplateSource types.String `tfsdk:"template_source"`
+}
+
+// ApiDefinitionVersionDataVersionsExtendedPathsTransformHeadersModel is the model of the version_data.versions.extended_paths.transform_headers objects.
+type ApiDefinitionVersionDataVersionsExtendedPathsTransformHeadersModel struct {
+	ActOn         types.Bool   `tfsdk:"act_on"`
+	AddHeaders    types.Map    `tfsdk:"add_headers"`
+	DeleteHeaders types.List   `tfsdk:"delete_headers"`
+	Disabled      types.Bool   `tfsdk:"disabled"`
+	Method        types.String `tfsdk:"method"`
+	Path          types.String `tfsdk:"path"`
+}
+
+// ApiDefinitionVersionDataVersionsExtendedPathsTransformJqModel is the model of the version_data.versions.extended_paths.transform_jq objects.
+type ApiDefinitionVersionDataVersionsExtendedPathsTransformJqModel struct {
+	Filter types.String `tfsdk:"filter"`
+	Method types.String `tfsdk:"method"`
+	Path   types.String `tfsdk:"path"`
+}
+
+// ApiDefinitionVersionDataVersionsExtendedPathsTransformJqResponseModel is the model of the version_data.versions.extended_paths.transform_jq_response objects.
+type ApiDefinitionVersionDataVersionsExtendedPathsTransformJqResponseModel struct {
+	Filter types.String `tfsdk:"filter"`
+	Method types.String `tfsdk:"method"`
+	Path   types.String `tfsdk:"path"`
+}
+
+// ApiDefinitionVersionDataVersionsExtendedPathsTransformResponseModel is the model of the version_data.versions.extended_paths.transform_response objects.
+type ApiDefinitionVersionDataVersionsExtendedPathsTransformResponseModel struct {
+	Disabled     types.Bool   `tfsdk:"disabled"`
+	Method       types.String `tfsdk:"method"`
+	Path         types.String `tfsdk:"path"`
+	TemplateData types.Object `tfsdk:"template_data"`
+}
+
+// ApiDefinitionVersionDataVersionsExtendedPathsTransformResponseTemplateDataModel is the model of the version_data.versions.extended_paths.transform_response.template_data objects.
+type ApiDefinitionVersionDataVersionsExtendedPathsTransformResponseTemplateDataModel struct {
+	EnableSession  types.Bool   `tfsdk:"enable_session"`
+	InputType      types.String `tfsdk:"input_type"`
+	TemplateMode   types.String `tfsdk:"template_mode"`
+	TemplateSource types.String `tfsdk:"template_source"`
+}
+
+// ApiDefinitionVersionDataVersionsExtendedPathsTransformResponseHeadersModel is the model of the version_data.versions.extended_paths.transform_response_headers objects.
+type ApiDefinitionVersionDataVersionsExtendedPathsTransformResponseHeadersModel struct {
+	ActOn         types.Bool   `tfsdk:"act_on"`
+	AddHeaders    types.Map    `tfsdk:"add_headers"`
+	DeleteHeaders types.List   `tfsdk:"delete_headers"`
+	Disabled      types.Bool   `tfsdk:"disabled"`
+	Method        types.String `tfsdk:"method"`
+	Path          types.String `tfsdk:"path"`
+}
+
+// ApiDefinitionVersionDataVersionsExtendedPathsUrlRewritesModel is the model of the version_data.versions.extended_paths.url_rewrites objects.
+type ApiDefinitionVersionDataVersionsExtendedPathsUrlRewritesModel struct {
+	Disabled     types.Bool   `tfsdk:"disabled"`
+	MatchPattern types.String `tfsdk:"match_pattern"`
+	Method       types.String `tfsdk:"method"`
+	Path         types.String `tfsdk:"path"`
+	RewriteTo    types.String `tfsdk:"rewrite_to"`
+	Triggers     types.List   `tfsdk:"triggers"`
+}
+
+// ApiDefinitionVersionDataVersionsExtendedPathsUrlRewritesTriggersModel is the model of the version_data.versions.extended_paths.url_rewrites.triggers objects.
+type ApiDefinitionVersionDataVersionsExtendedPathsUrlRewritesTriggersModel struct {
+	On        types.String `tfsdk:"on"`
+	Options   types.Object `tfsdk:"options"`
+	RewriteTo types.String `tfsdk:"rewrite_to"`
+}
+
+// ApiDefinitionVersionDataVersionsExtendedPathsUrlRewritesTriggersOptionsModel is the model of the version_data.versions.extended_paths.url_rewrites.triggers.options objects.
+type ApiDefinitionVersionDataVersionsExtendedPathsUrlRewritesTriggersOptionsModel struct {
+	HeaderMatches         types.Map    `tfsdk:"header_matches"`
+	PathPartMatches       types.Map    `tfsdk:"path_part_matches"`
+	PayloadMatches        types.Object `tfsdk:"payload_matches"`
+	QueryValMatches       types.Map    `tfsdk:"query_val_matches"`
+	RequestContextMatches types.Map    `tfsdk:"request_context_matches"`
+	SessionMetaMatches    types.Map    `tfsdk:"session_meta_matches"`
+}
+
+// ApiDefinitionVersionDataVersionsExtendedPathsUrlRewritesTriggersOptionsHeaderMatchesModel is the model of the version_data.versions.extended_paths.url_rewrites.triggers.options.header_matches objects.
+type ApiDefinitionVersionDataVersionsExtendedPathsUrlRewritesTriggersOptionsHeaderMatchesModel struct {
+	MatchRx types.String `tfsdk:"match_rx"`
+	Reverse types.Bool   `tfsdk:"reverse"`
+}
+
+// ApiDefinitionVersionDataVersionsExtendedPathsUrlRewritesTriggersOptionsPathPartMatchesModel is the model of the version_data.versions.extended_paths.url_rewrites.triggers.options.path_part_matches objects.
+type ApiDefinitionVersionDataVersionsExtendedPathsUrlRewritesTriggersOptionsPathPartMatchesModel struct {
+	MatchRx types.String `tfsdk:"match_rx"`
+	Reverse types.Bool   `tfsdk:"reverse"`
+}
+
+// ApiDefinitionVersionDataVersionsExtendedPathsUrlRewritesTriggersOptionsPayloadMatchesModel is the model of the version_data.versions.extended_paths.url_rewrites.triggers.options.payload_matches objects.
+type ApiDefinitionVersionDataVersionsExtendedPathsUrlRewritesTriggersOptionsPayloadMatchesModel struct {
+	MatchRx types.String `tfsdk:"match_rx"`
+	Reverse types.Bool   `tfsdk:"reverse"`
+}
+
+// ApiDefinitionVersionDataVersionsExtendedPathsUrlRewritesTriggersOptionsQueryValMatchesModel is the model of the version_data.versions.extended_paths.url_rewrites.triggers.options.query_val_matches objects.
+type ApiDefinitionVersionDataVersionsExtendedPathsUrlRewritesTriggersOptionsQueryValMatchesModel struct {
+	MatchRx types.String `tfsdk:"match_rx"`
+	Reverse types.Bool   `tfsdk:"reverse"`
+}
+
+// ApiDefinitionVersionDataVersionsExtendedPathsUrlRewritesTriggersOptionsRequestContextMatchesModel is the model of the version_data.versions.extended_paths.url_rewrites.triggers.options.request_context_matches objects.
+type ApiDefinitionVersionDataVersionsExtendedPathsUrlRewritesTriggersOptionsRequestContextMatchesModel struct {
+	MatchRx types.String `tfsdk:"match_rx"`
+	Reverse types.Bool   `tfsdk:"reverse"`
+}
+
+// ApiDefinitionVersionDataVersionsExtendedPathsUrlRewritesTriggersOptionsSessionMetaMatchesModel is the model of the version_data.versions.extended_paths.url_rewrites.triggers.options.session_meta_matches objects.
+type ApiDefinitionVersionDataVersionsExtendedPathsUrlRewritesTriggersOptionsSessionMetaMatchesModel struct {
+	MatchRx types.String `tfsdk:"match_rx"`
+	Reverse types.Bool   `tfsdk:"reverse"`
+}
+
+// ApiDefinitionVersionDataVersionsExtendedPathsValidateJsonModel is the model of the version_data.versions.extended_paths.validate_json objects.
+type ApiDefinitionVersionDataVersionsExtendedPathsValidateJsonModel struct {
+	Disabled          types.Bool   `tfsdk:"disabled"`
+	ErrorResponseCode types.Int64  `tfsdk:"error_response_code"`
+	Method            types.String `tfsdk:"method"`
+	Path              types.String `tfsdk:"path"`
+	SchemaB64         types.String `tfsdk:"schema_b64"`
+}
+
+// ApiDefinitionVersionDataVersionsExtendedPathsValidateRequestModel is the model of the version_data.versions.extended_paths.validate_request objects.
+type ApiDefinitionVersionDataVersionsExtendedPathsValidateRequestModel struct {
+	Enabled           types.Bool   `tfsdk:"enabled"`
+	ErrorResponseCode types.Int64  `tfsdk:"error_response_code"`
+	Method            types.String `tfsdk:"method"`
+	Path              types.String `tfsdk:"path"`
+}
+
+// ApiDefinitionVersionDataVersionsExtendedPathsVirtualModel is the model of the version_data.versions.extended_paths.virtual objects.
+type ApiDefinitionVersionDataVersionsExtendedPathsVirtualModel struct {
+	Disabled             types.Bool   `tfsdk:"disabled"`
+	FunctionSourceType   types.String `tfsdk:"function_source_type"`
+	FunctionSourceUri    types.String `tfsdk:"function_source_uri"`
+	Method               types.String `tfsdk:"method"`
+	Path                 types.String `tfsdk:"path"`
+	ProxyOnError         types.Bool   `tfsdk:"proxy_on_error"`
+	ResponseFunctionName types.String `tfsdk:"response_function_name"`
+	UseSession           types.Bool   `tfsdk:"use_session"`
+}
+
+// ApiDefinitionVersionDataVersionsExtendedPathsWhiteListModel is the model of the version_data.versions.extended_paths.white_list objects.
+type ApiDefinitionVersionDataVersionsExtendedPathsWhiteListModel struct {
+	Disabled      types.Bool   `tfsdk:"disabled"`
+	IgnoreCase    types.Bool   `tfsdk:"ignore_case"`
+	Method        types.String `tfsdk:"method"`
+	MethodActions types.Map    `tfsdk:"method_actions"`
+	Path          types.String `tfsdk:"path"`
+}
+
+// ApiDefinitionVersionDataVersionsExtendedPathsWhiteListMethodActionsModel is the model of the version_data.versions.extended_paths.white_list.method_actions objects.
+type ApiDefinitionVersionDataVersionsExtendedPathsWhiteListMethodActionsModel struct {
+	Action  types.String `tfsdk:"action"`
+	Code    types.Int64  `tfsdk:"code"`
+	Data    types.String `tfsdk:"data"`
+	Headers types.Map    `tfsdk:"headers"`
+}
+
+// ApiDefinitionVersionDataVersionsPathsModel is the model of the version_data.versions.paths objects.
+type ApiDefinitionVersionDataVersionsPathsModel struct {
+	BlackList types.List `tfsdk:"black_list"`
+	Ignored   types.List `tfsdk:"ignored"`
+	WhiteList types.List `tfsdk:"white_list"`
+}
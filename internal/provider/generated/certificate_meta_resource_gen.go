@@ -0,0 +1,51 @@
+// Code generated by internal/codegen from provider_code_spec.json. DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// CertificateMetaResourceSchema returns the certificate_meta resource schema.
+func CertificateMetaResourceSchema(ctx context.Context) schema.Schema {
+	return schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"dns_names": schema.ListAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"fingerprint": schema.StringAttribute{
+				Optional: true,
+			},
+			"has_private": schema.BoolAttribute{
+				Optional: true,
+			},
+			"id": schema.StringAttribute{
+				Optional: true,
+			},
+			"is_ca": schema.BoolAttribute{
+				Optional: true,
+			},
+			"not_after": schema.StringAttribute{
+				Optional: true,
+			},
+			"not_before": schema.StringAttribute{
+				Optional: true,
+			},
+		},
+	}
+}
+
+// CertificateMetaModel is the model of the certificate_meta resource schema.
+type CertificateMetaModel struct {
+	DnsNames    types.List   `tfsdk:"dns_names"`
+	Fingerprint types.String `tfsdk:"fingerprint"`
+	HasPrivate  types.Bool   `tfsdk:"has_private"`
+	Id          types.String `tfsdk:"id"`
+	IsCa        types.Bool   `tfsdk:"is_ca"`
+	NotAfter    types.String `tfsdk:"not_after"`
+	NotBefore   types.String `tfsdk:"not_before"`
+}
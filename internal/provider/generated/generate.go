@@ -0,0 +1,7 @@
+// Package generated holds framework schemas and models generated from the
+// components of gateway-swagger.yml. Run go generate after changing the spec
+// or generator_config.yml, rather than editing the generated files.
+package generated
+
+//go:generate go run terraform-provider-tykgateway/internal/codegen spec -config ../../../generator_config.yml -input ../../../gateway-swagger.yml -output ../../../provider_code_spec.json
+//go:generate go run terraform-provider-tykgateway/internal/codegen framework -input ../../../provider_code_spec.json -output .
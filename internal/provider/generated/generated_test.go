@@ -0,0 +1,50 @@
+package generated
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestResourceSchemas(t *testing.T) {
+	tests := map[string]struct {
+		schema func(context.Context) schema.Schema
+		model  any
+	}{
+		"api_definition":     {ApiDefinitionResourceSchema, &ApiDefinitionModel{}},
+		"certificate_meta":   {CertificateMetaResourceSchema, &CertificateMetaModel{}},
+		"oauth_client":       {OauthClientResourceSchema, &OauthClientModel{}},
+		"oauth_client_token": {OauthClientTokenResourceSchema, &OauthClientTokenModel{}},
+		"policy":             {PolicyResourceSchema, &PolicyModel{}},
+		"session_state":      {SessionStateResourceSchema, &SessionStateModel{}},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			s := test.schema(ctx)
+
+			if diags := s.ValidateImplementation(ctx); diags.HasError() {
+				t.Fatalf("invalid schema: %v", diags)
+			}
+
+			// Reading a state with every attribute null checks that the
+			// model matches the schema.
+			objectType := s.Type().TerraformType(ctx).(tftypes.Object)
+			values := map[string]tftypes.Value{}
+			for name, attributeType := range objectType.AttributeTypes {
+				values[name] = tftypes.NewValue(attributeType, nil)
+			}
+			state := tfsdk.State{
+				Schema: s,
+				Raw:    tftypes.NewValue(objectType, values),
+			}
+			if diags := state.Get(ctx, test.model); diags.HasError() {
+				t.Errorf("model does not match the schema: %v", diags)
+			}
+		})
+	}
+}
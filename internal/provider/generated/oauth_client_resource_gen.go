@@ -0,0 +1,54 @@
+// Code generated by internal/codegen from provider_code_spec.json. DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// OauthClientResourceSchema returns the oauth_client resource schema.
+func OauthClientResourceSchema(ctx context.Context) schema.Schema {
+	return schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"api_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the OAuth API the client belongs to.",
+			},
+			"client_id": schema.StringAttribute{
+				Computed:    true,
+				Optional:    true,
+				Description: "The client ID. Generated by the gateway when not set.",
+			},
+			"description": schema.StringAttribute{
+				Optional:    true,
+				Description: "The client description.",
+			},
+			"meta_data": schema.MapAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: "Metadata attached to the client.",
+			},
+			"policy_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "The policy applied to tokens issued to the client.",
+			},
+			"redirect_uri": schema.StringAttribute{
+				Required:    true,
+				Description: "The redirect URI of the client.",
+			},
+		},
+	}
+}
+
+// OauthClientModel is the model of the oauth_client resource schema.
+type OauthClientModel struct {
+	ApiId       types.String `tfsdk:"api_id"`
+	ClientId    types.String `tfsdk:"client_id"`
+	Description types.String `tfsdk:"description"`
+	MetaData    types.Map    `tfsdk:"meta_data"`
+	PolicyId    types.String `tfsdk:"policy_id"`
+	RedirectUri types.String `tfsdk:"redirect_uri"`
+}
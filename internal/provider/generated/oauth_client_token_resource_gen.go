@@ -0,0 +1,30 @@
+// Code generated by internal/codegen from provider_code_spec.json. DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// OauthClientTokenResourceSchema returns the oauth_client_token resource schema.
+func OauthClientTokenResourceSchema(ctx context.Context) schema.Schema {
+	return schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"code": schema.StringAttribute{
+				Optional: true,
+			},
+			"expires": schema.Int64Attribute{
+				Optional: true,
+			},
+		},
+	}
+}
+
+// OauthClientTokenModel is the model of the oauth_client_token resource schema.
+type OauthClientTokenModel struct {
+	Code    types.String `tfsdk:"code"`
+	Expires types.Int64  `tfsdk:"expires"`
+}
@@ -0,0 +1,437 @@
+// Code generated by internal/codegen from provider_code_spec.json. DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// PolicyResourceSchema returns the policy resource schema.
+func PolicyResourceSchema(ctx context.Context) schema.Schema {
+	return schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"access_rights": schema.MapNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"allowance_scope": schema.StringAttribute{
+							Optional: true,
+						},
+						"allowed_types": schema.ListNestedAttribute{
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"fields": schema.ListAttribute{
+										ElementType: types.StringType,
+										Optional:    true,
+									},
+									"name": schema.StringAttribute{
+										Optional: true,
+									},
+								},
+							},
+							Optional: true,
+						},
+						"allowed_urls": schema.ListNestedAttribute{
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"methods": schema.ListAttribute{
+										ElementType: types.StringType,
+										Optional:    true,
+									},
+									"url": schema.StringAttribute{
+										Optional: true,
+									},
+								},
+							},
+							Optional: true,
+						},
+						"api_id": schema.StringAttribute{
+							Optional: true,
+						},
+						"api_name": schema.StringAttribute{
+							Optional: true,
+						},
+						"disable_introspection": schema.BoolAttribute{
+							Optional: true,
+						},
+						"endpoints": schema.ListNestedAttribute{
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"methods": schema.ListNestedAttribute{
+										NestedObject: schema.NestedAttributeObject{
+											Attributes: map[string]schema.Attribute{
+												"limit": schema.SingleNestedAttribute{
+													Attributes: map[string]schema.Attribute{
+														"per": schema.Float64Attribute{
+															Optional: true,
+														},
+														"rate": schema.Float64Attribute{
+															Optional: true,
+														},
+														"smoothing": schema.SingleNestedAttribute{
+															Attributes: map[string]schema.Attribute{
+																"delay": schema.Int64Attribute{
+																	Optional: true,
+																},
+																"enabled": schema.BoolAttribute{
+																	Optional: true,
+																},
+																"step": schema.Int64Attribute{
+																	Optional: true,
+																},
+																"threshold": schema.Int64Attribute{
+																	Optional: true,
+																},
+																"trigger": schema.Float64Attribute{
+																	Optional: true,
+																},
+															},
+															Optional: true,
+														},
+													},
+													Optional: true,
+												},
+												"name": schema.StringAttribute{
+													Optional: true,
+												},
+											},
+										},
+										Optional: true,
+									},
+									"path": schema.StringAttribute{
+										Optional: true,
+									},
+								},
+							},
+							Optional: true,
+						},
+						"field_access_rights": schema.ListNestedAttribute{
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"field_name": schema.StringAttribute{
+										Optional: true,
+									},
+									"limits": schema.SingleNestedAttribute{
+										Attributes: map[string]schema.Attribute{
+											"max_query_depth": schema.Int64Attribute{
+												Optional: true,
+											},
+										},
+										Optional: true,
+									},
+									"type_name": schema.StringAttribute{
+										Optional: true,
+									},
+								},
+							},
+							Optional: true,
+						},
+						"limit": schema.SingleNestedAttribute{
+							Attributes: map[string]schema.Attribute{
+								"max_query_depth": schema.Int64Attribute{
+									Optional: true,
+								},
+								"per": schema.Float64Attribute{
+									Optional: true,
+								},
+								"quota_max": schema.Int64Attribute{
+									Optional: true,
+								},
+								"quota_remaining": schema.Int64Attribute{
+									Optional: true,
+								},
+								"quota_renewal_rate": schema.Int64Attribute{
+									Optional: true,
+								},
+								"quota_renews": schema.Int64Attribute{
+									Optional: true,
+								},
+								"rate": schema.Float64Attribute{
+									Optional: true,
+								},
+								"smoothing": schema.SingleNestedAttribute{
+									Attributes: map[string]schema.Attribute{
+										"delay": schema.Int64Attribute{
+											Optional: true,
+										},
+										"enabled": schema.BoolAttribute{
+											Optional: true,
+										},
+										"step": schema.Int64Attribute{
+											Optional: true,
+										},
+										"threshold": schema.Int64Attribute{
+											Optional: true,
+										},
+										"trigger": schema.Float64Attribute{
+											Optional: true,
+										},
+									},
+									Optional: true,
+								},
+								"throttle_interval": schema.Float64Attribute{
+									Optional: true,
+								},
+								"throttle_retry_limit": schema.Int64Attribute{
+									Optional: true,
+								},
+							},
+							Optional: true,
+						},
+						"restricted_types": schema.ListNestedAttribute{
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"fields": schema.ListAttribute{
+										ElementType: types.StringType,
+										Optional:    true,
+									},
+									"name": schema.StringAttribute{
+										Optional: true,
+									},
+								},
+							},
+							Optional: true,
+						},
+						"versions": schema.ListAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+						},
+					},
+				},
+				Optional: true,
+			},
+			"active": schema.BoolAttribute{
+				Optional: true,
+			},
+			"enable_http_signature_validation": schema.BoolAttribute{
+				Optional: true,
+			},
+			"hmac_enabled": schema.BoolAttribute{
+				Optional: true,
+			},
+			"id": schema.StringAttribute{
+				Optional: true,
+			},
+			"is_inactive": schema.BoolAttribute{
+				Optional: true,
+			},
+			"key_expires_in": schema.Int64Attribute{
+				Optional: true,
+			},
+			"last_updated": schema.StringAttribute{
+				Optional: true,
+			},
+			"max_query_depth": schema.Int64Attribute{
+				Optional: true,
+			},
+			"name": schema.StringAttribute{
+				Optional: true,
+			},
+			"org_id": schema.StringAttribute{
+				Optional: true,
+			},
+			"partitions": schema.SingleNestedAttribute{
+				Attributes: map[string]schema.Attribute{
+					"acl": schema.BoolAttribute{
+						Optional: true,
+					},
+					"complexity": schema.BoolAttribute{
+						Optional: true,
+					},
+					"per_api": schema.BoolAttribute{
+						Optional: true,
+					},
+					"quota": schema.BoolAttribute{
+						Optional: true,
+					},
+					"rate_limit": schema.BoolAttribute{
+						Optional: true,
+					},
+				},
+				Optional: true,
+			},
+			"per": schema.Float64Attribute{
+				Optional: true,
+			},
+			"quota_max": schema.Int64Attribute{
+				Optional: true,
+			},
+			"quota_renewal_rate": schema.Int64Attribute{
+				Optional: true,
+			},
+			"rate": schema.Float64Attribute{
+				Optional: true,
+			},
+			"smoothing": schema.SingleNestedAttribute{
+				Attributes: map[string]schema.Attribute{
+					"delay": schema.Int64Attribute{
+						Optional: true,
+					},
+					"enabled": schema.BoolAttribute{
+						Optional: true,
+					},
+					"step": schema.Int64Attribute{
+						Optional: true,
+					},
+					"threshold": schema.Int64Attribute{
+						Optional: true,
+					},
+					"trigger": schema.Float64Attribute{
+						Optional: true,
+					},
+				},
+				Optional: true,
+			},
+			"tags": schema.ListAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"throttle_interval": schema.Float64Attribute{
+				Optional: true,
+			},
+			"throttle_retry_limit": schema.Int64Attribute{
+				Optional: true,
+			},
+		},
+	}
+}
+
+// PolicyModel is the model of the policy resource schema.
+type PolicyModel struct {
+	AccessRights                  types.Map     `tfsdk:"access_rights"`
+	Active                        types.Bool    `tfsdk:"active"`
+	EnableHttpSignatureValidation types.Bool    `tfsdk:"enable_http_signature_validation"`
+	HmacEnabled                   types.Bool    `tfsdk:"hmac_enabled"`
+	Id                            types.String  `tfsdk:"id"`
+	IsInactive                    types.Bool    `tfsdk:"is_inactive"`
+	KeyExpiresIn                  types.Int64   `tfsdk:"key_expires_in"`
+	LastUpdated                   types.String  `tfsdk:"last_updated"`
+	MaxQueryDepth                 types.Int64   `tfsdk:"max_query_depth"`
+	Name                          types.String  `tfsdk:"name"`
+	OrgId                         types.String  `tfsdk:"org_id"`
+	Partitions                    types.Object  `tfsdk:"partitions"`
+	Per                           types.Float64 `tfsdk:"per"`
+	QuotaMax                      types.Int64   `tfsdk:"quota_max"`
+	QuotaRenewalRate              types.Int64   `tfsdk:"quota_renewal_rate"`
+	Rate                          types.Float64 `tfsdk:"rate"`
+	Smoothing                     types.Object  `tfsdk:"smoothing"`
+	Tags                          types.List    `tfsdk:"tags"`
+	ThrottleInterval              types.Float64 `tfsdk:"throttle_interval"`
+	ThrottleRetryLimit            types.Int64   `tfsdk:"throttle_retry_limit"`
+}
+
+// PolicyAccessRightsModel is the model of the access_rights objects.
+type PolicyAccessRightsModel struct {
+	AllowanceScope       types.String `tfsdk:"allowance_scope"`
+	AllowedTypes         types.List   `tfsdk:"allowed_types"`
+	AllowedUrls          types.List   `tfsdk:"allowed_urls"`
+	ApiId                types.String `tfsdk:"api_id"`
+	ApiName              types.String `tfsdk:"api_name"`
+	DisableIntrospection types.Bool   `tfsdk:"disable_introspection"`
+	Endpoints            types.List   `tfsdk:"endpoints"`
+	FieldAccessRights    types.List   `tfsdk:"field_access_rights"`
+	Limit                types.Object `tfsdk:"limit"`
+	RestrictedTypes      types.List   `tfsdk:"restricted_types"`
+	Versions             types.List   `tfsdk:"versions"`
+}
+
+// PolicyAccessRightsAllowedTypesModel is the model of the access_rights.allowed_types objects.
+type PolicyAccessRightsAllowedTypesModel struct {
+	Fields types.List   `tfsdk:"fields"`
+	Name   types.String `tfsdk:"name"`
+}
+
+// PolicyAccessRightsAllowedUrlsModel is the model of the access_rights.allowed_urls objects.
+type PolicyAccessRightsAllowedUrlsModel struct {
+	Methods types.List   `tfsdk:"methods"`
+	Url     types.String `tfsdk:"url"`
+}
+
+// PolicyAccessRightsEndpointsModel is the model of the access_rights.endpoints objects.
+type PolicyAccessRightsEndpointsModel struct {
+	Methods types.List   `tfsdk:"methods"`
+	Path    types.String `tfsdk:"path"`
+}
+
+// PolicyAccessRightsEndpointsMethodsModel is the model of the access_rights.endpoints.methods objects.
+type PolicyAccessRightsEndpointsMethodsModel struct {
+	Limit types.Object `tfsdk:"limit"`
+	Name  types.String `tfsdk:"name"`
+}
+
+// PolicyAccessRightsEndpointsMethodsLimitModel is the model of the access_rights.endpoints.methods.limit objects.
+type PolicyAccessRightsEndpointsMethodsLimitModel struct {
+	Per       types.Float64 `tfsdk:"per"`
+	Rate      types.Float64 `tfsdk:"rate"`
+	Smoothing types.Object  `tfsdk:"smoothing"`
+}
+
+// PolicyAccessRightsEndpointsMethodsLimitSmoothingModel is the model of the access_rights.endpoints.methods.limit.smoothing objects.
+type PolicyAccessRightsEndpointsMethodsLimitSmoothingModel struct {
+	Delay     types.Int64   `tfsdk:"delay"`
+	Enabled   types.Bool    `tfsdk:"enabled"`
+	Step      types.Int64   `tfsdk:"step"`
+	Threshold types.Int64   `tfsdk:"threshold"`
+	Trigger   types.Float64 `tfsdk:"trigger"`
+}
+
+// PolicyAccessRightsFieldAccessRightsModel is the model of the access_rights.field_access_rights objects.
+type PolicyAccessRightsFieldAccessRightsModel struct {
+	FieldName types.String `tfsdk:"field_name"`
+	Limits    types.Object `tfsdk:"limits"`
+	TypeName  types.String `tfsdk:"type_name"`
+}
+
+// PolicyAccessRightsFieldAccessRightsLimitsModel is the model of the access_rights.field_access_rights.limits objects.
+type PolicyAccessRightsFieldAccessRightsLimitsModel struct {
+	MaxQueryDepth types.Int64 `tfsdk:"max_query_depth"`
+}
+
+// PolicyAccessRightsLimitModel is the model of the access_rights.limit objects.
+type PolicyAccessRightsLimitModel struct {
+	MaxQueryDepth      types.Int64   `tfsdk:"max_query_depth"`
+	Per                types.Float64 `tfsdk:"per"`
+	QuotaMax           types.Int64   `tfsdk:"quota_max"`
+	QuotaRemaining     types.Int64   `tfsdk:"quota_remaining"`
+	QuotaRenewalRate   types.Int64   `tfsdk:"quota_renewal_rate"`
+	QuotaRenews        types.Int64   `tfsdk:"quota_renews"`
+	Rate               types.Float64 `tfsdk:"rate"`
+	Smoothing          types.Object  `tfsdk:"smoothing"`
+	ThrottleInterval   types.Float64 `tfsdk:"throttle_interval"`
+	ThrottleRetryLimit types.Int64   `tfsdk:"throttle_retry_limit"`
+}
+
+// PolicyAccessRightsLimitSmoothingModel is the model of the access_rights.limit.smoothing objects.
+type PolicyAccessRightsLimitSmoothingModel struct {
+	Delay     types.Int64   `tfsdk:"delay"`
+	Enabled   types.Bool    `tfsdk:"enabled"`
+	Step      types.Int64   `tfsdk:"step"`
+	Threshold types.Int64   `tfsdk:"threshold"`
+	Trigger   types.Float64 `tfsdk:"trigger"`
+}
+
+// PolicyAccessRightsRestrictedTypesModel is the model of the access_rights.restricted_types objects.
+type PolicyAccessRightsRestrictedTypesModel struct {
+	Fields types.List   `tfsdk:"fields"`
+	Name   types.String `tfsdk:"name"`
+}
+
+// PolicyPartitionsModel is the model of the partitions objects.
+type PolicyPartitionsModel struct {
+	Acl        types.Bool `tfsdk:"acl"`
+	Complexity types.Bool `tfsdk:"complexity"`
+	PerApi     types.Bool `tfsdk:"per_api"`
+	Quota      types.Bool `tfsdk:"quota"`
+	RateLimit  types.Bool `tfsdk:"rate_limit"`
+}
+
+// PolicySmoothingModel is the model of the smoothing objects.
+type PolicySmoothingModel struct {
+	Delay     types.Int64   `tfsdk:"delay"`
+	Enabled   types.Bool    `tfsdk:"enabled"`
+	Step      types.Int64   `tfsdk:"step"`
+	Threshold types.Int64   `tfsdk:"threshold"`
+	Trigger   types.Float64 `tfsdk:"trigger"`
+}
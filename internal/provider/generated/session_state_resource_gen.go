@@ -0,0 +1,522 @@
+// Code generated by internal/codegen from provider_code_spec.json. DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// SessionStateResourceSchema returns the session_state resource schema.
+func SessionStateResourceSchema(ctx context.Context) schema.Schema {
+	return schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"access_rights": schema.MapNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"allowance_scope": schema.StringAttribute{
+							Optional: true,
+						},
+						"allowed_types": schema.ListNestedAttribute{
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"fields": schema.ListAttribute{
+										ElementType: types.StringType,
+										Optional:    true,
+									},
+									"name": schema.StringAttribute{
+										Optional: true,
+									},
+								},
+							},
+							Optional: true,
+						},
+						"allowed_urls": schema.ListNestedAttribute{
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"methods": schema.ListAttribute{
+										ElementType: types.StringType,
+										Optional:    true,
+									},
+									"url": schema.StringAttribute{
+										Optional: true,
+									},
+								},
+							},
+							Optional: true,
+						},
+						"api_id": schema.StringAttribute{
+							Optional: true,
+						},
+						"api_name": schema.StringAttribute{
+							Optional: true,
+						},
+						"disable_introspection": schema.BoolAttribute{
+							Optional: true,
+						},
+						"endpoints": schema.ListNestedAttribute{
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"methods": schema.ListNestedAttribute{
+										NestedObject: schema.NestedAttributeObject{
+											Attributes: map[string]schema.Attribute{
+												"limit": schema.SingleNestedAttribute{
+													Attributes: map[string]schema.Attribute{
+														"per": schema.Float64Attribute{
+															Optional: true,
+														},
+														"rate": schema.Float64Attribute{
+															Optional: true,
+														},
+														"smoothing": schema.SingleNestedAttribute{
+															Attributes: map[string]schema.Attribute{
+																"delay": schema.Int64Attribute{
+																	Optional: true,
+																},
+																"enabled": schema.BoolAttribute{
+																	Optional: true,
+																},
+																"step": schema.Int64Attribute{
+																	Optional: true,
+																},
+																"threshold": schema.Int64Attribute{
+																	Optional: true,
+																},
+																"trigger": schema.Float64Attribute{
+																	Optional: true,
+																},
+															},
+															Optional: true,
+														},
+													},
+													Optional: true,
+												},
+												"name": schema.StringAttribute{
+													Optional: true,
+												},
+											},
+										},
+										Optional: true,
+									},
+									"path": schema.StringAttribute{
+										Optional: true,
+									},
+								},
+							},
+							Optional: true,
+						},
+						"field_access_rights": schema.ListNestedAttribute{
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"field_name": schema.StringAttribute{
+										Optional: true,
+									},
+									"limits": schema.SingleNestedAttribute{
+										Attributes: map[string]schema.Attribute{
+											"max_query_depth": schema.Int64Attribute{
+												Optional: true,
+											},
+										},
+										Optional: true,
+									},
+									"type_name": schema.StringAttribute{
+										Optional: true,
+									},
+								},
+							},
+							Optional: true,
+						},
+						"limit": schema.SingleNestedAttribute{
+							Attributes: map[string]schema.Attribute{
+								"max_query_depth": schema.Int64Attribute{
+									Optional: true,
+								},
+								"per": schema.Float64Attribute{
+									Optional: true,
+								},
+								"quota_max": schema.Int64Attribute{
+									Optional: true,
+								},
+								"quota_remaining": schema.Int64Attribute{
+									Optional: true,
+								},
+								"quota_renewal_rate": schema.Int64Attribute{
+									Optional: true,
+								},
+								"quota_renews": schema.Int64Attribute{
+									Optional: true,
+								},
+								"rate": schema.Float64Attribute{
+									Optional: true,
+								},
+								"smoothing": schema.SingleNestedAttribute{
+									Attributes: map[string]schema.Attribute{
+										"delay": schema.Int64Attribute{
+											Optional: true,
+										},
+										"enabled": schema.BoolAttribute{
+											Optional: true,
+										},
+										"step": schema.Int64Attribute{
+											Optional: true,
+										},
+										"threshold": schema.Int64Attribute{
+											Optional: true,
+										},
+										"trigger": schema.Float64Attribute{
+											Optional: true,
+										},
+									},
+									Optional: true,
+								},
+								"throttle_interval": schema.Float64Attribute{
+									Optional: true,
+								},
+								"throttle_retry_limit": schema.Int64Attribute{
+									Optional: true,
+								},
+							},
+							Optional: true,
+						},
+						"restricted_types": schema.ListNestedAttribute{
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"fields": schema.ListAttribute{
+										ElementType: types.StringType,
+										Optional:    true,
+									},
+									"name": schema.StringAttribute{
+										Optional: true,
+									},
+								},
+							},
+							Optional: true,
+						},
+						"versions": schema.ListAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+						},
+					},
+				},
+				Optional: true,
+			},
+			"alias": schema.StringAttribute{
+				Optional: true,
+			},
+			"allowance": schema.Float64Attribute{
+				Optional: true,
+			},
+			"apply_policies": schema.ListAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"apply_policy_id": schema.StringAttribute{
+				Optional:           true,
+				Description:        "deprecated use apply_policies going forward instead to send a list of policies ids",
+				DeprecationMessage: "Deprecated by the Tyk Gateway API.",
+			},
+			"basic_auth_data": schema.SingleNestedAttribute{
+				Attributes: map[string]schema.Attribute{
+					"hash_type": schema.StringAttribute{
+						Optional: true,
+					},
+					"password": schema.StringAttribute{
+						Optional:  true,
+						Sensitive: true,
+					},
+				},
+				Optional: true,
+			},
+			"certificate": schema.StringAttribute{
+				Optional: true,
+			},
+			"data_expires": schema.Int64Attribute{
+				Optional: true,
+			},
+			"date_created": schema.StringAttribute{
+				Optional: true,
+			},
+			"enable_detail_recording": schema.BoolAttribute{
+				Optional:           true,
+				Description:        "deprecated use enable_detailed_recording going forward instead",
+				DeprecationMessage: "Deprecated by the Tyk Gateway API.",
+			},
+			"enable_detailed_recording": schema.BoolAttribute{
+				Optional: true,
+			},
+			"enable_http_signature_validation": schema.BoolAttribute{
+				Optional: true,
+			},
+			"expires": schema.Int64Attribute{
+				Optional: true,
+			},
+			"hmac_enabled": schema.BoolAttribute{
+				Optional: true,
+			},
+			"hmac_string": schema.StringAttribute{
+				Optional:  true,
+				Sensitive: true,
+			},
+			"id_extractor_deadline": schema.Int64Attribute{
+				Optional: true,
+			},
+			"is_inactive": schema.BoolAttribute{
+				Optional: true,
+			},
+			"jwt_data": schema.SingleNestedAttribute{
+				Attributes: map[string]schema.Attribute{
+					"secret": schema.StringAttribute{
+						Optional: true,
+					},
+				},
+				Optional: true,
+			},
+			"last_check": schema.Int64Attribute{
+				Optional: true,
+			},
+			"last_updated": schema.StringAttribute{
+				Optional: true,
+			},
+			"max_query_depth": schema.Int64Attribute{
+				Optional: true,
+			},
+			"monitor": schema.SingleNestedAttribute{
+				Attributes: map[string]schema.Attribute{
+					"trigger_limits": schema.ListAttribute{
+						ElementType: types.Float64Type,
+						Optional:    true,
+					},
+				},
+				Optional: true,
+			},
+			"oauth_client_id": schema.StringAttribute{
+				Optional: true,
+			},
+			"oauth_keys": schema.MapAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"org_id": schema.StringAttribute{
+				Optional: true,
+			},
+			"per": schema.Float64Attribute{
+				Optional: true,
+			},
+			"quota_max": schema.Int64Attribute{
+				Optional: true,
+			},
+			"quota_remaining": schema.Int64Attribute{
+				Optional: true,
+			},
+			"quota_renewal_rate": schema.Int64Attribute{
+				Optional: true,
+			},
+			"quota_renews": schema.Int64Attribute{
+				Optional: true,
+			},
+			"rate": schema.Float64Attribute{
+				Optional: true,
+			},
+			"rsa_certificate_id": schema.StringAttribute{
+				Optional: true,
+			},
+			"session_lifetime": schema.Int64Attribute{
+				Optional: true,
+			},
+			"smoothing": schema.SingleNestedAttribute{
+				Attributes: map[string]schema.Attribute{
+					"delay": schema.Int64Attribute{
+						Optional: true,
+					},
+					"enabled": schema.BoolAttribute{
+						Optional: true,
+					},
+					"step": schema.Int64Attribute{
+						Optional: true,
+					},
+					"threshold": schema.Int64Attribute{
+						Optional: true,
+					},
+					"trigger": schema.Float64Attribute{
+						Optional: true,
+					},
+				},
+				Optional: true,
+			},
+			"tags": schema.ListAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"throttle_interval": schema.Float64Attribute{
+				Optional: true,
+			},
+			"throttle_retry_limit": schema.Int64Attribute{
+				Optional: true,
+			},
+		},
+	}
+}
+
+// SessionStateModel is the model of the session_state resource schema.
+type SessionStateModel struct {
+	AccessRights                  types.Map     `tfsdk:"access_rights"`
+	Alias                         types.String  `tfsdk:"alias"`
+	Allowance                     types.Float64 `tfsdk:"allowance"`
+	ApplyPolicies                 types.List    `tfsdk:"apply_policies"`
+	ApplyPolicyId                 types.String  `tfsdk:"apply_policy_id"`
+	BasicAuthData                 types.Object  `tfsdk:"basic_auth_data"`
+	Certificate                   types.String  `tfsdk:"certificate"`
+	DataExpires                   types.Int64   `tfsdk:"data_expires"`
+	DateCreated                   types.String  `tfsdk:"date_created"`
+	EnableDetailRecording         types.Bool    `tfsdk:"enable_detail_recording"`
+	EnableDetailedRecording       types.Bool    `tfsdk:"enable_detailed_recording"`
+	EnableHttpSignatureValidation types.Bool    `tfsdk:"enable_http_signature_validation"`
+	Expires                       types.Int64   `tfsdk:"expires"`
+	HmacEnabled                   types.Bool    `tfsdk:"hmac_enabled"`
+	HmacString                    types.String  `tfsdk:"hmac_string"`
+	IdExtractorDeadline           types.Int64   `tfsdk:"id_extractor_deadline"`
+	IsInactive                    types.Bool    `tfsdk:"is_inactive"`
+	JwtData                       types.Object  `tfsdk:"jwt_data"`
+	LastCheck                     types.Int64   `tfsdk:"last_check"`
+	LastUpdated                   types.String  `tfsdk:"last_updated"`
+	MaxQueryDepth                 types.Int64   `tfsdk:"max_query_depth"`
+	Monitor                       types.Object  `tfsdk:"monitor"`
+	OauthClientId                 types.String  `tfsdk:"oauth_client_id"`
+	OauthKeys                     types.Map     `tfsdk:"oauth_keys"`
+	OrgId                         types.String  `tfsdk:"org_id"`
+	Per                           types.Float64 `tfsdk:"per"`
+	QuotaMax                      types.Int64   `tfsdk:"quota_max"`
+	QuotaRemaining                types.Int64   `tfsdk:"quota_remaining"`
+	QuotaRenewalRate              types.Int64   `tfsdk:"quota_renewal_rate"`
+	QuotaRenews                   types.Int64   `tfsdk:"quota_renews"`
+	Rate                          types.Float64 `tfsdk:"rate"`
+	RsaCertificateId              types.String  `tfsdk:"rsa_certificate_id"`
+	SessionLifetime               types.Int64   `tfsdk:"session_lifetime"`
+	Smoothing                     types.Object  `tfsdk:"smoothing"`
+	Tags                          types.List    `tfsdk:"tags"`
+	ThrottleInterval              types.Float64 `tfsdk:"throttle_interval"`
+	ThrottleRetryLimit            types.Int64   `tfsdk:"throttle_retry_limit"`
+}
+
+// SessionStateAccessRightsModel is the model of the access_rights objects.
+type SessionStateAccessRightsModel struct {
+	AllowanceScope       types.String `tfsdk:"allowance_scope"`
+	AllowedTypes         types.List   `tfsdk:"allowed_types"`
+	AllowedUrls          types.List   `tfsdk:"allowed_urls"`
+	ApiId                types.String `tfsdk:"api_id"`
+	ApiName              types.String `tfsdk:"api_name"`
+	DisableIntrospection types.Bool   `tfsdk:"disable_introspection"`
+	Endpoints            types.List   `tfsdk:"endpoints"`
+	FieldAccessRights    types.List   `tfsdk:"field_access_rights"`
+	Limit                types.Object `tfsdk:"limit"`
+	RestrictedTypes      types.List   `tfsdk:"restricted_types"`
+	Versions             types.List   `tfsdk:"versions"`
+}
+
+// SessionStateAccessRightsAllowedTypesModel is the model of the access_rights.allowed_types objects.
+type SessionStateAccessRightsAllowedTypesModel struct {
+	Fields types.List   `tfsdk:"fields"`
+	Name   types.String `tfsdk:"name"`
+}
+
+// SessionStateAccessRightsAllowedUrlsModel is the model of the access_rights.allowed_urls objects.
+type SessionStateAccessRightsAllowedUrlsModel struct {
+	Methods types.List   `tfsdk:"methods"`
+	Url     types.String `tfsdk:"url"`
+}
+
+// SessionStateAccessRightsEndpointsModel is the model of the access_rights.endpoints objects.
+type SessionStateAccessRightsEndpointsModel struct {
+	Methods types.List   `tfsdk:"methods"`
+	Path    types.String `tfsdk:"path"`
+}
+
+// SessionStateAccessRightsEndpointsMethodsModel is the model of the access_rights.endpoints.methods objects.
+type SessionStateAccessRightsEndpointsMethodsModel struct {
+	Limit types.Object `tfsdk:"limit"`
+	Name  types.String `tfsdk:"name"`
+}
+
+// SessionStateAccessRightsEndpointsMethodsLimitModel is the model of the access_rights.endpoints.methods.limit objects.
+type SessionStateAccessRightsEndpointsMethodsLimitModel struct {
+	Per       types.Float64 `tfsdk:"per"`
+	Rate      types.Float64 `tfsdk:"rate"`
+	Smoothing types.Object  `tfsdk:"smoothing"`
+}
+
+// SessionStateAccessRightsEndpointsMethodsLimitSmoothingModel is the model of the access_rights.endpoints.methods.limit.smoothing objects.
+type SessionStateAccessRightsEndpointsMethodsLimitSmoothingModel struct {
+	Delay     types.Int64   `tfsdk:"delay"`
+	Enabled   types.Bool    `tfsdk:"enabled"`
+	Step      types.Int64   `tfsdk:"step"`
+	Threshold types.Int64   `tfsdk:"threshold"`
+	Trigger   types.Float64 `tfsdk:"trigger"`
+}
+
+// SessionStateAccessRightsFieldAccessRightsModel is the model of the access_rights.field_access_rights objects.
+type SessionStateAccessRightsFieldAccessRightsModel struct {
+	FieldName types.String `tfsdk:"field_name"`
+	Limits    types.Object `tfsdk:"limits"`
+	TypeName  types.String `tfsdk:"type_name"`
+}
+
+// SessionStateAccessRightsFieldAccessRightsLimitsModel is the model of the access_rights.field_access_rights.limits objects.
+type SessionStateAccessRightsFieldAccessRightsLimitsModel struct {
+	MaxQueryDepth types.Int64 `tfsdk:"max_query_depth"`
+}
+
+// SessionStateAccessRightsLimitModel is the model of the access_rights.limit objects.
+type SessionStateAccessRightsLimitModel struct {
+	MaxQueryDepth      types.Int64   `tfsdk:"max_query_depth"`
+	Per                types.Float64 `tfsdk:"per"`
+	QuotaMax           types.Int64   `tfsdk:"quota_max"`
+	QuotaRemaining     types.Int64   `tfsdk:"quota_remaining"`
+	QuotaRenewalRate   types.Int64   `tfsdk:"quota_renewal_rate"`
+	QuotaRenews        types.Int64   `tfsdk:"quota_renews"`
+	Rate               types.Float64 `tfsdk:"rate"`
+	Smoothing          types.Object  `tfsdk:"smoothing"`
+	ThrottleInterval   types.Float64 `tfsdk:"throttle_interval"`
+	ThrottleRetryLimit types.Int64   `tfsdk:"throttle_retry_limit"`
+}
+
+// SessionStateAccessRightsLimitSmoothingModel is the model of the access_rights.limit.smoothing objects.
+type SessionStateAccessRightsLimitSmoothingModel struct {
+	Delay     types.Int64   `tfsdk:"delay"`
+	Enabled   types.Bool    `tfsdk:"enabled"`
+	Step      types.Int64   `tfsdk:"step"`
+	Threshold types.Int64   `tfsdk:"threshold"`
+	Trigger   types.Float64 `tfsdk:"trigger"`
+}
+
+// SessionStateAccessRightsRestrictedTypesModel is the model of the access_rights.restricted_types objects.
+type SessionStateAccessRightsRestrictedTypesModel struct {
+	Fields types.List   `tfsdk:"fields"`
+	Name   types.String `tfsdk:"name"`
+}
+
+// SessionStateBasicAuthDataModel is the model of the basic_auth_data objects.
+type SessionStateBasicAuthDataModel struct {
+	HashType types.String `tfsdk:"hash_type"`
+	Password types.String `tfsdk:"password"`
+}
+
+// SessionStateJwtDataModel is the model of the jwt_data objects.
+type SessionStateJwtDataModel struct {
+	Secret types.String `tfsdk:"secret"`
+}
+
+// SessionStateMonitorModel is the model of the monitor objects.
+type SessionStateMonitorModel struct {
+	TriggerLimits types.List `tfsdk:"trigger_limits"`
+}
+
+// SessionStateSmoothingModel is the model of the smoothing objects.
+type SessionStateSmoothingModel struct {
+	Delay     types.Int64   `tfsdk:"delay"`
+	Enabled   types.Bool    `tfsdk:"enabled"`
+	Step      types.Int64   `tfsdk:"step"`
+	Threshold types.Int64   `tfsdk:"threshold"`
+	Trigger   types.Float64 `tfsdk:"trigger"`
+}
@@ -0,0 +1,76 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"terraform-provider-tykgateway/client"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+func hotReloadModes() []string {
+	var modes []string
+	for _, mode := range client.ReloadModes {
+		modes = append(modes, string(mode))
+	}
+	return modes
+}
+
+// reloadAfterChange tells the reloader of c about a change to an API or a
+// policy, and returns once the gateways have been reloaded for it. The change
+// itself has been applied, so a failed reload is only a warning.
+func reloadAfterChange(ctx context.Context, c *client.Client, diags *diag.Diagnostics) {
+	err := c.Reloader.Changed(ctx)
+	if err != nil {
+		diags.AddWarning(
+			"Error reloading Tyk Gateway",
+			"The change was applied, but the gateways could not be reloaded for it to take effect: "+err.Error(),
+		)
+	}
+}
+
+// readLoaded reads an API or a policy. The gateway only serves the APIs and
+// policies it loaded on its last reload, so an object created or changed
+// since is not found, or found as it was, until the next reload. With
+// hot_reload on, when the object is not found, even on only some nodes of a
+// cluster, or changed reports that it differs from the state, the gateways
+// are reloaded and the object is read again, so that this really means it
+// was deleted or changed outside of Terraform. With hot_reload off, the
+// gateways are never reloaded and the object is returned as read.
+func readLoaded[T any](ctx context.Context, c *client.Client, get func(ctx context.Context) (T, error), changed func(live T) bool) (T, error) {
+	live, err := get(ctx)
+	if c.Reloader == nil {
+		return live, err
+	}
+
+	missing := client.IsNotFound(err) || errors.Is(err, client.ErrMissingOnSomeNodes)
+	if err == nil && !changed(live) || err != nil && !missing {
+		return live, err
+	}
+
+	_, err = c.ReloadContext(ctx, true)
+	if err != nil {
+		var zero T
+		return zero, fmt.Errorf("reloading to read the loaded object: %w", err)
+	}
+	return get(ctx)
+}
+
+// removeIfReloaded handles an API or a policy readLoaded did not find. With
+// hot_reload on, the gateways have been reloaded, so it was deleted outside
+// of Terraform and is removed from the state to be recreated. With hot_reload
+// off, the gateways may only not have loaded it yet, so the state is kept.
+func removeIfReloaded(ctx context.Context, c *client.Client, state *tfsdk.State, diags *diag.Diagnostics, kind string) {
+	if c.Reloader != nil {
+		state.RemoveResource(ctx)
+		return
+	}
+
+	diags.AddWarning(
+		"Tyk Gateway "+kind+" not loaded",
+		"The gateway does not serve the "+kind+", which may only mean that it has not been reloaded since the "+kind+" was created. "+
+			"It is kept in the state; set hot_reload to tell this apart from a deletion outside of Terraform.",
+	)
+}
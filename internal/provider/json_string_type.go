@@ -0,0 +1,225 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var _ basetypes.StringTypable = jsonStringType{}
+var _ basetypes.StringValuableWithSemanticEquals = jsonStringValue{}
+var _ xattr.ValidateableAttribute = jsonStringValue{}
+
+// jsonStringType is a string attribute type holding a JSON document. Two
+// documents are considered equal when they only differ in formatting, key
+// order, number notation or in object fields set to null versus absent.
+type jsonStringType struct {
+	basetypes.StringType
+}
+
+func (t jsonStringType) Equal(o attr.Type) bool {
+	other, ok := o.(jsonStringType)
+	if !ok {
+		return false
+	}
+
+	return t.StringType.Equal(other.StringType)
+}
+
+func (t jsonStringType) String() string {
+	return "jsonStringType"
+}
+
+func (t jsonStringType) ValueFromString(ctx context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return jsonStringValue{StringValue: in}, nil
+}
+
+func (t jsonStringType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	stringValuable, diags := t.ValueFromString(ctx, stringValue)
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to StringValuable: %v", diags)
+	}
+
+	return stringValuable, nil
+}
+
+func (t jsonStringType) ValueType(ctx context.Context) attr.Value {
+	return jsonStringValue{}
+}
+
+type jsonStringValue struct {
+	basetypes.StringValue
+}
+
+func newJsonStringValue(value string) jsonStringValue {
+	return jsonStringValue{StringValue: basetypes.NewStringValue(value)}
+}
+
+func (v jsonStringValue) Equal(o attr.Value) bool {
+	other, ok := o.(jsonStringValue)
+	if !ok {
+		return false
+	}
+
+	return v.StringValue.Equal(other.StringValue)
+}
+
+func (v jsonStringValue) Type(ctx context.Context) attr.Type {
+	return jsonStringType{}
+}
+
+func (v jsonStringValue) StringSemanticEquals(ctx context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(jsonStringValue)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			fmt.Sprintf("Expected value type %T but got value type %T. Please report this to the provider developers.", v, newValuable),
+		)
+		return false, diags
+	}
+
+	oldDocument, err := normalizedJson(v.ValueString())
+	if err != nil {
+		diags.AddError("Semantic Equality Check Error", "Could not parse JSON: "+err.Error())
+		return false, diags
+	}
+
+	newDocument, err := normalizedJson(newValue.ValueString())
+	if err != nil {
+		diags.AddError("Semantic Equality Check Error", "Could not parse JSON: "+err.Error())
+		return false, diags
+	}
+
+	return jsonValuesEqual(oldDocument, newDocument), diags
+}
+
+func (v jsonStringValue) ValidateAttribute(ctx context.Context, req xattr.ValidateAttributeRequest, resp *xattr.ValidateAttributeResponse) {
+	if v.IsNull() || v.IsUnknown() {
+		return
+	}
+
+	if !json.Valid([]byte(v.ValueString())) {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid JSON String Value",
+			"A string value was provided that is not valid JSON.\n\n"+
+				"Given Value: "+v.ValueString()+"\n",
+		)
+	}
+}
+
+// normalizedJson decodes a JSON document into a form where semantically equal
+// documents are equal according to jsonValuesEqual.
+func normalizedJson(document string) (any, error) {
+	decoder := json.NewDecoder(bytes.NewReader([]byte(document)))
+	decoder.UseNumber()
+
+	var decoded any
+	if err := decoder.Decode(&decoded); err != nil {
+		return nil, err
+	}
+
+	return normalizeJsonValue(decoded), nil
+}
+
+func normalizeJsonValue(value any) any {
+	switch value := value.(type) {
+	case map[string]any:
+		normalized := make(map[string]any, len(value))
+		for field, fieldValue := range value {
+			// The gateway omits null fields, treat them as absent.
+			if fieldValue == nil {
+				continue
+			}
+			normalized[field] = normalizeJsonValue(fieldValue)
+		}
+		return normalized
+	case []any:
+		normalized := make([]any, 0, len(value))
+		for _, item := range value {
+			normalized = append(normalized, normalizeJsonValue(item))
+		}
+		return normalized
+	case json.Number:
+		// 1, 1.0 and 1e0 are the same number, but not the same as "1".
+		number, ok := new(big.Rat).SetString(value.String())
+		if !ok {
+			return value
+		}
+		return number
+	default:
+		return value
+	}
+}
+
+// jsonDocumentsEqual reports whether two JSON document values are
+// semantically equal.
+func jsonDocumentsEqual(a, b jsonStringValue) bool {
+	if a.IsNull() || b.IsNull() {
+		return a.IsNull() == b.IsNull()
+	}
+
+	aDocument, err := normalizedJson(a.ValueString())
+	if err != nil {
+		return false
+	}
+	bDocument, err := normalizedJson(b.ValueString())
+	if err != nil {
+		return false
+	}
+	return jsonValuesEqual(aDocument, bDocument)
+}
+
+// jsonValuesEqual compares two normalized JSON values.
+func jsonValuesEqual(a, b any) bool {
+	switch a := a.(type) {
+	case map[string]any:
+		b, ok := b.(map[string]any)
+		if !ok || len(a) != len(b) {
+			return false
+		}
+		for field, value := range a {
+			other, ok := b[field]
+			if !ok || !jsonValuesEqual(value, other) {
+				return false
+			}
+		}
+		return true
+	case []any:
+		b, ok := b.([]any)
+		if !ok || len(a) != len(b) {
+			return false
+		}
+		for i := range a {
+			if !jsonValuesEqual(a[i], b[i]) {
+				return false
+			}
+		}
+		return true
+	case *big.Rat:
+		b, ok := b.(*big.Rat)
+		return ok && a.Cmp(b) == 0
+	default:
+		return a == b
+	}
+}
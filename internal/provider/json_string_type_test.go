@@ -0,0 +1,82 @@
+package provider
+
+import (
+	"context"
+	"testing"
+)
+
+func TestJsonStringSemanticEquals(t *testing.T) {
+	testCases := []struct {
+		name     string
+		oldValue string
+		newValue string
+		expected bool
+	}{
+		{
+			name:     "formatting",
+			oldValue: `{"rate":1000,"per":1}`,
+			newValue: "{\n  \"rate\": 1000,\n  \"per\": 1\n}",
+			expected: true,
+		},
+		{
+			name:     "key order",
+			oldValue: `{"rate":1000,"per":1}`,
+			newValue: `{"per":1,"rate":1000}`,
+			expected: true,
+		},
+		{
+			name:     "number notation",
+			oldValue: `{"rate":1000,"per":1}`,
+			newValue: `{"rate":1e3,"per":1.0}`,
+			expected: true,
+		},
+		{
+			name:     "null versus absent",
+			oldValue: `{"rate":1000,"access_rights":{"api1":{"api_id":"api1"}}}`,
+			newValue: `{"rate":1000,"tags":null,"access_rights":{"api1":{"api_id":"api1","versions":null}}}`,
+			expected: true,
+		},
+		{
+			name:     "number versus string",
+			oldValue: `{"rate":1}`,
+			newValue: `{"rate":"1"}`,
+			expected: false,
+		},
+		{
+			name:     "string versus number",
+			oldValue: `{"tags":["1"]}`,
+			newValue: `{"tags":[1.0]}`,
+			expected: false,
+		},
+		{
+			name:     "different value",
+			oldValue: `{"rate":1000}`,
+			newValue: `{"rate":100}`,
+			expected: false,
+		},
+		{
+			name:     "list order",
+			oldValue: `{"tags":["a","b"]}`,
+			newValue: `{"tags":["b","a"]}`,
+			expected: false,
+		},
+		{
+			name:     "empty versus null list",
+			oldValue: `{"tags":[]}`,
+			newValue: `{"tags":null}`,
+			expected: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			equal, diags := newJsonStringValue(testCase.oldValue).StringSemanticEquals(context.Background(), newJsonStringValue(testCase.newValue))
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+			if equal != testCase.expected {
+				t.Errorf("expected %t, got %t", testCase.expected, equal)
+			}
+		})
+	}
+}
@@ -0,0 +1,136 @@
+package provider
+
+import "encoding/json"
+
+// serverManagedKeyFields are session fields the gateway maintains on its own.
+// They never count as drift, wherever they appear in the session.
+var serverManagedKeyFields = map[string]bool{
+	"quota_remaining":       true,
+	"quota_renews":          true,
+	"date_created":          true,
+	"last_updated":          true,
+	"last_check":            true,
+	"id_extractor_deadline": true,
+}
+
+// keyConfigMapFields hold maps keyed by arbitrary names rather than objects
+// with a fixed set of fields, so entries added in the gateway are drift.
+var keyConfigMapFields = map[string]bool{
+	"access_rights": true,
+	"meta_data":     true,
+}
+
+// refreshKeyConfig projects the live session onto the shape of the configured
+// key_config. Only fields present in the configuration are refreshed, so
+// defaults the gateway fills in do not show up as changes.
+func refreshKeyConfig(config map[string]any, live map[string]any) map[string]any {
+	return refreshObject(config, live)
+}
+
+// refreshJsonDocument refreshes a JSON document attribute from the live
+// object like refreshKeyConfig. An imported resource has no document yet and
+// takes the whole live object.
+func refreshJsonDocument(document jsonStringValue, live map[string]any) (jsonStringValue, error) {
+	var refreshed any
+	if document.IsNull() {
+		refreshed = stripServerManagedFields(live)
+	} else {
+		var config map[string]any
+		err := json.Unmarshal([]byte(document.ValueString()), &config)
+		if err != nil {
+			return document, err
+		}
+		refreshed = refreshObject(config, live)
+	}
+
+	rb, err := json.Marshal(refreshed)
+	if err != nil {
+		return document, err
+	}
+	return newJsonStringValue(string(rb)), nil
+}
+
+func refreshObject(config map[string]any, live map[string]any) map[string]any {
+	refreshed := make(map[string]any, len(config))
+
+	for field, configValue := range config {
+		if serverManagedKeyFields[field] {
+			refreshed[field] = configValue
+			continue
+		}
+
+		liveValue, ok := live[field]
+		if !ok {
+			continue
+		}
+
+		refreshed[field] = refreshValue(configValue, liveValue, keyConfigMapFields[field])
+	}
+
+	return refreshed
+}
+
+func refreshValue(configValue any, liveValue any, isMap bool) any {
+	// The gateway reports empty lists and maps as null.
+	if liveValue == nil && isEmpty(configValue) {
+		return configValue
+	}
+
+	configObject, configIsObject := configValue.(map[string]any)
+	liveObject, liveIsObject := liveValue.(map[string]any)
+	if !configIsObject || !liveIsObject {
+		return liveValue
+	}
+
+	if !isMap {
+		return refreshObject(configObject, liveObject)
+	}
+
+	// Configured entries are refreshed individually, entries added in the
+	// gateway are kept whole.
+	refreshed := make(map[string]any, len(liveObject))
+	for name, liveEntry := range liveObject {
+		configEntry, ok := configObject[name]
+		if !ok {
+			refreshed[name] = stripServerManagedFields(liveEntry)
+			continue
+		}
+		refreshed[name] = refreshValue(configEntry, liveEntry, false)
+	}
+	return refreshed
+}
+
+// stripServerManagedFields removes server managed fields from value and any
+// object nested in it.
+func stripServerManagedFields(value any) any {
+	switch value := value.(type) {
+	case map[string]any:
+		stripped := make(map[string]any, len(value))
+		for field, fieldValue := range value {
+			if serverManagedKeyFields[field] {
+				continue
+			}
+			stripped[field] = stripServerManagedFields(fieldValue)
+		}
+		return stripped
+	case []any:
+		stripped := make([]any, 0, len(value))
+		for _, item := range value {
+			stripped = append(stripped, stripServerManagedFields(item))
+		}
+		return stripped
+	default:
+		return value
+	}
+}
+
+func isEmpty(value any) bool {
+	switch value := value.(type) {
+	case map[string]any:
+		return len(value) == 0
+	case []any:
+		return len(value) == 0
+	default:
+		return false
+	}
+}
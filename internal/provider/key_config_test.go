@@ -0,0 +1,82 @@
+package provider
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestRefreshKeyConfig(t *testing.T) {
+	testCases := []struct {
+		name     string
+		config   string
+		live     string
+		expected string
+	}{
+		{
+			name:     "unchanged session",
+			config:   `{"rate":1000,"per":1,"org_id":"default"}`,
+			live:     `{"rate":1000,"per":1,"org_id":"default","allowance":1000,"expires":0,"tags":null}`,
+			expected: `{"rate":1000,"per":1,"org_id":"default"}`,
+		},
+		{
+			name:     "changed rate",
+			config:   `{"rate":1000,"per":1}`,
+			live:     `{"rate":50,"per":1}`,
+			expected: `{"rate":50,"per":1}`,
+		},
+		{
+			name:     "server managed fields are ignored",
+			config:   `{"quota_max":100,"quota_remaining":100}`,
+			live:     `{"quota_max":100,"quota_remaining":42,"date_created":"2024-08-09T14:40:34Z","last_updated":"1723203634"}`,
+			expected: `{"quota_max":100,"quota_remaining":100}`,
+		},
+		{
+			name:     "nested access rights",
+			config:   `{"access_rights":{"api1":{"api_id":"api1","limit":{"rate":10}}}}`,
+			live:     `{"access_rights":{"api1":{"api_id":"api1","api_name":"","versions":null,"limit":{"rate":20,"per":0,"quota_remaining":3}}}}`,
+			expected: `{"access_rights":{"api1":{"api_id":"api1","limit":{"rate":20}}}}`,
+		},
+		{
+			name:     "access rights added in the gateway",
+			config:   `{"access_rights":{"api1":{"api_id":"api1"}}}`,
+			live:     `{"access_rights":{"api1":{"api_id":"api1"},"api2":{"api_id":"api2","limit":{"quota_remaining":3}}}}`,
+			expected: `{"access_rights":{"api1":{"api_id":"api1"},"api2":{"api_id":"api2","limit":{}}}}`,
+		},
+		{
+			name:     "removed field",
+			config:   `{"alias":"portal-key"}`,
+			live:     `{}`,
+			expected: `{}`,
+		},
+		{
+			name:     "empty map reported as null",
+			config:   `{"meta_data":{}}`,
+			live:     `{"meta_data":null}`,
+			expected: `{"meta_data":{}}`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			config := decodeTestJSON(t, testCase.config)
+			live := decodeTestJSON(t, testCase.live)
+			expected := decodeTestJSON(t, testCase.expected)
+
+			refreshed := refreshKeyConfig(config, live)
+			if !reflect.DeepEqual(refreshed, expected) {
+				t.Errorf("expected %v, got %v", expected, refreshed)
+			}
+		})
+	}
+}
+
+func decodeTestJSON(t *testing.T, raw string) map[string]any {
+	t.Helper()
+
+	var decoded map[string]any
+	if err := json.Unmarshal([]byte(raw), &decoded); err != nil {
+		t.Fatal(err)
+	}
+	return decoded
+}
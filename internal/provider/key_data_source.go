@@ -0,0 +1,277 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"terraform-provider-tykgateway/client"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	resourceschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &keyDataSource{}
+var _ datasource.DataSourceWithConfigure = &keyDataSource{}
+var _ datasource.DataSourceWithValidateConfig = &keyDataSource{}
+
+func NewKeyDataSource() datasource.DataSource {
+	return &keyDataSource{}
+}
+
+type keyDataSource struct {
+	client *client.Client
+}
+
+type keyDataSourceModel struct {
+	Key            types.String                     `tfsdk:"key"`
+	KeyHash        types.String                     `tfsdk:"key_hash"`
+	KeyConfig      jsonStringValue                  `tfsdk:"key_config"`
+	AccessRights   map[string]accessDefinitionModel `tfsdk:"access_rights"`
+	QuotaRemaining types.Int64                      `tfsdk:"quota_remaining"`
+	Expires        types.Int64                      `tfsdk:"expires"`
+	IsInactive     types.Bool                       `tfsdk:"is_inactive"`
+	ApplyPolicies  []types.String                   `tfsdk:"apply_policies"`
+	Tags           []types.String                   `tfsdk:"tags"`
+}
+
+func (d *keyDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_key"
+}
+
+func (d *keyDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	accessRights, err := accessRightsDataSourceAttribute()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid key data source schema",
+			"Could not derive access_rights from the key resource schema: "+err.Error(),
+		)
+		return
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Looks up a key by the key or its hash.",
+		Attributes: map[string]schema.Attribute{
+			"key": schema.StringAttribute{
+				Description: "The key. Conflicts with key_hash.",
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"key_hash": schema.StringAttribute{
+				Description: "The key hash. Conflicts with key.",
+				Optional:    true,
+			},
+			"key_config": schema.StringAttribute{
+				Description: "The key session json string. Sensitive, as it holds the HMAC secret and basic auth password of the key.",
+				CustomType:  jsonStringType{},
+				Computed:    true,
+				Sensitive:   true,
+			},
+			"access_rights": accessRights,
+			"quota_remaining": schema.Int64Attribute{
+				Description: "The number of requests left in the current quota period.",
+				Computed:    true,
+			},
+			"expires": schema.Int64Attribute{
+				Description: "The unix timestamp at which the key expires, 0 for never.",
+				Computed:    true,
+			},
+			"is_inactive": schema.BoolAttribute{
+				Description: "Indicates if the key is disabled.",
+				Computed:    true,
+			},
+			"apply_policies": schema.ListAttribute{
+				Description: "IDs of the policies applied to the key.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"tags": schema.ListAttribute{
+				Description: "Tags attached to the key.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// accessRightsDataSourceAttribute describes the access_rights of a key read by
+// a data source. It is derived from accessRightsAttribute, so that both keep
+// the same attributes.
+func accessRightsDataSourceAttribute() (schema.Attribute, error) {
+	return computedAttribute(accessRightsAttribute())
+}
+
+// computedAttribute converts a resource attribute into a computed data source
+// attribute with the same type, description and nested attributes.
+func computedAttribute(attribute resourceschema.Attribute) (schema.Attribute, error) {
+	switch attribute := attribute.(type) {
+	case resourceschema.StringAttribute:
+		return schema.StringAttribute{Description: attribute.Description, CustomType: attribute.CustomType, Sensitive: attribute.Sensitive, Computed: true}, nil
+	case resourceschema.BoolAttribute:
+		return schema.BoolAttribute{Description: attribute.Description, CustomType: attribute.CustomType, Sensitive: attribute.Sensitive, Computed: true}, nil
+	case resourceschema.Int64Attribute:
+		return schema.Int64Attribute{Description: attribute.Description, CustomType: attribute.CustomType, Sensitive: attribute.Sensitive, Computed: true}, nil
+	case resourceschema.Int32Attribute:
+		return schema.Int32Attribute{Description: attribute.Description, CustomType: attribute.CustomType, Sensitive: attribute.Sensitive, Computed: true}, nil
+	case resourceschema.Float64Attribute:
+		return schema.Float64Attribute{Description: attribute.Description, CustomType: attribute.CustomType, Sensitive: attribute.Sensitive, Computed: true}, nil
+	case resourceschema.Float32Attribute:
+		return schema.Float32Attribute{Description: attribute.Description, CustomType: attribute.CustomType, Sensitive: attribute.Sensitive, Computed: true}, nil
+	case resourceschema.NumberAttribute:
+		return schema.NumberAttribute{Description: attribute.Description, CustomType: attribute.CustomType, Sensitive: attribute.Sensitive, Computed: true}, nil
+	case resourceschema.ListAttribute:
+		return schema.ListAttribute{Description: attribute.Description, ElementType: attribute.ElementType, CustomType: attribute.CustomType, Sensitive: attribute.Sensitive, Computed: true}, nil
+	case resourceschema.SetAttribute:
+		return schema.SetAttribute{Description: attribute.Description, ElementType: attribute.ElementType, CustomType: attribute.CustomType, Sensitive: attribute.Sensitive, Computed: true}, nil
+	case resourceschema.MapAttribute:
+		return schema.MapAttribute{Description: attribute.Description, ElementType: attribute.ElementType, CustomType: attribute.CustomType, Sensitive: attribute.Sensitive, Computed: true}, nil
+	case resourceschema.ObjectAttribute:
+		return schema.ObjectAttribute{Description: attribute.Description, AttributeTypes: attribute.AttributeTypes, CustomType: attribute.CustomType, Sensitive: attribute.Sensitive, Computed: true}, nil
+	case resourceschema.ListNestedAttribute:
+		nestedObject, err := computedNestedObject(attribute.NestedObject)
+		return schema.ListNestedAttribute{Description: attribute.Description, NestedObject: nestedObject, CustomType: attribute.CustomType, Sensitive: attribute.Sensitive, Computed: true}, err
+	case resourceschema.SetNestedAttribute:
+		nestedObject, err := computedNestedObject(attribute.NestedObject)
+		return schema.SetNestedAttribute{Description: attribute.Description, NestedObject: nestedObject, CustomType: attribute.CustomType, Sensitive: attribute.Sensitive, Computed: true}, err
+	case resourceschema.MapNestedAttribute:
+		nestedObject, err := computedNestedObject(attribute.NestedObject)
+		return schema.MapNestedAttribute{Description: attribute.Description, NestedObject: nestedObject, CustomType: attribute.CustomType, Sensitive: attribute.Sensitive, Computed: true}, err
+	case resourceschema.SingleNestedAttribute:
+		attributes, err := computedAttributes(attribute.Attributes)
+		return schema.SingleNestedAttribute{Description: attribute.Description, Attributes: attributes, CustomType: attribute.CustomType, Sensitive: attribute.Sensitive, Computed: true}, err
+	default:
+		return nil, fmt.Errorf("unsupported attribute type %T", attribute)
+	}
+}
+
+func computedNestedObject(object resourceschema.NestedAttributeObject) (schema.NestedAttributeObject, error) {
+	attributes, err := computedAttributes(object.Attributes)
+	return schema.NestedAttributeObject{Attributes: attributes, CustomType: object.CustomType}, err
+}
+
+func computedAttributes(attributes map[string]resourceschema.Attribute) (map[string]schema.Attribute, error) {
+	result := make(map[string]schema.Attribute, len(attributes))
+	for name, attribute := range attributes {
+		computed, err := computedAttribute(attribute)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", name, err)
+		}
+		result[name] = computed
+	}
+	return result, nil
+}
+
+func (d *keyDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var data keyDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Unknown values are only resolved at apply time.
+	if data.Key.IsUnknown() || data.KeyHash.IsUnknown() {
+		return
+	}
+
+	if data.Key.IsNull() == data.KeyHash.IsNull() {
+		resp.Diagnostics.AddError(
+			"Invalid key lookup",
+			"Exactly one of key or key_hash must be set.",
+		)
+	}
+}
+
+func (d *keyDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			"Expected *client.Client, got something else.",
+		)
+		return
+	}
+
+	d.client = client
+
+	if d.client == nil {
+		resp.Diagnostics.AddError(
+			"Client Not Configured",
+			"The client is not configured, please check your provider configuration.",
+		)
+	}
+}
+
+func (d *keyDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data keyDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Read API call logic
+	keyId := data.Key.ValueString()
+	hashed := data.Key.IsNull()
+	if hashed {
+		keyId = data.KeyHash.ValueString()
+	}
+	key, err := d.client.GetKeyWithHashedContext(ctx, keyId, hashed)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading key",
+			clientErrorDetail("Could not read key", err),
+		)
+		return
+	}
+
+	rb, err := json.Marshal(key)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error encoding key JSON",
+			"Could not encode key JSON, unexpected error: "+err.Error(),
+		)
+		return
+	}
+	data.KeyConfig = newJsonStringValue(string(rb))
+
+	session, err := key.SessionState()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading key",
+			"Could not decode key session, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	data.AccessRights = make(map[string]accessDefinitionModel, len(session.AccessRights))
+	for apiId, accessDefinition := range session.AccessRights {
+		data.AccessRights[apiId] = accessDefinitionFromClient(accessDefinition)
+	}
+	data.QuotaRemaining = types.Int64Value(session.QuotaRemaining)
+	data.Expires = types.Int64Value(session.Expires)
+	data.IsInactive = types.BoolValue(session.IsInactive)
+	data.ApplyPolicies = stringsOrEmpty(stringsFromClient(session.ApplyPolicies))
+	data.Tags = stringsOrEmpty(stringsFromClient(session.Tags))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// stringsOrEmpty reads missing lists as empty, so that they can be iterated
+// over without a null check.
+func stringsOrEmpty(values []types.String) []types.String {
+	if values == nil {
+		return []types.String{}
+	}
+	return values
+}
@@ -0,0 +1,84 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccKeyDataSource(t *testing.T) {
+
+	t.Setenv("TF_ACC", "1")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+resource "tykgateway_key" "key1" {
+  hashed    = true
+  org_id    = "default"
+  quota_max = 100
+  expires   = 4102444800
+  tags      = ["terraform"]
+
+  access_rights = {
+    "httpbin-api" = {
+      api_name = "Httpbin API"
+      versions = ["Default"]
+    }
+  }
+}
+
+data "tykgateway_key" "by_key" {
+  key = tykgateway_key.key1.key
+}
+
+data "tykgateway_key" "by_hash" {
+  key_hash = tykgateway_key.key1.key_hash
+}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.tykgateway_key.by_key", "access_rights.httpbin-api.api_name", "Httpbin API"),
+					resource.TestCheckResourceAttr("data.tykgateway_key.by_key", "access_rights.httpbin-api.versions.0", "Default"),
+					resource.TestCheckResourceAttr("data.tykgateway_key.by_key", "quota_remaining", "100"),
+					resource.TestCheckResourceAttr("data.tykgateway_key.by_key", "expires", "4102444800"),
+					resource.TestCheckResourceAttr("data.tykgateway_key.by_key", "is_inactive", "false"),
+					resource.TestCheckResourceAttr("data.tykgateway_key.by_key", "tags.0", "terraform"),
+					resource.TestCheckResourceAttr("data.tykgateway_key.by_key", "apply_policies.#", "0"),
+					resource.TestMatchResourceAttr("data.tykgateway_key.by_key", "key_config", regexp.MustCompile(`"org_id":"default"`)),
+					resource.TestCheckResourceAttrPair("data.tykgateway_key.by_hash", "key_config", "data.tykgateway_key.by_key", "key_config"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKeyDataSourceInvalidLookup(t *testing.T) {
+
+	t.Setenv("TF_ACC", "1")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+data "tykgateway_key" "key1" {
+  key      = "key1"
+  key_hash = "hash1"
+}`,
+				ExpectError: regexp.MustCompile("Exactly one of key or key_hash must be set"),
+			},
+		},
+	})
+}
+
+func TestComputedAttributeKeySession(t *testing.T) {
+	// Every attribute the key resource uses converts, so that the data source
+	// can derive its attributes from it.
+	for name, attribute := range keySessionAttributes() {
+		if _, err := computedAttribute(attribute); err != nil {
+			t.Errorf("attribute %s: %v", name, err)
+		}
+	}
+}
@@ -3,15 +3,27 @@ package provider
 import (
 	"context"
 	"encoding/json"
+	"strings"
 	"terraform-provider-tykgateway/client"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 var _ resource.Resource = &keyResource{}
 var _ resource.ResourceWithConfigure = &keyResource{}
+var _ resource.ResourceWithImportState = &keyResource{}
+var _ resource.ResourceWithValidateConfig = &keyResource{}
+
+// hashedKeyImportPrefix marks import IDs that are key hashes rather than keys.
+const hashedKeyImportPrefix = "hash:"
 
 func NewKeyResource() resource.Resource {
 	return &keyResource{}
@@ -22,10 +34,28 @@ type keyResource struct {
 }
 
 type keyResourceModel struct {
-	Hashed    types.Bool   `tfsdk:"hashed"`
-	KeyConfig types.String `tfsdk:"key_config"`
-	Key       types.String `tfsdk:"key"`
-	KeyHash   types.String `tfsdk:"key_hash"`
+	Hashed             types.Bool                       `tfsdk:"hashed"`
+	KeyConfig          jsonStringValue                  `tfsdk:"key_config"`
+	Key                types.String                     `tfsdk:"key"`
+	KeyHash            types.String                     `tfsdk:"key_hash"`
+	CustomKey          types.String                     `tfsdk:"custom_key"`
+	OrgId              types.String                     `tfsdk:"org_id"`
+	Alias              types.String                     `tfsdk:"alias"`
+	Rate               types.Float64                    `tfsdk:"rate"`
+	Per                types.Float64                    `tfsdk:"per"`
+	ThrottleInterval   types.Float64                    `tfsdk:"throttle_interval"`
+	ThrottleRetryLimit types.Int64                      `tfsdk:"throttle_retry_limit"`
+	MaxQueryDepth      types.Int64                      `tfsdk:"max_query_depth"`
+	QuotaMax           types.Int64                      `tfsdk:"quota_max"`
+	QuotaRenewalRate   types.Int64                      `tfsdk:"quota_renewal_rate"`
+	Expires            types.Int64                      `tfsdk:"expires"`
+	IsInactive         types.Bool                       `tfsdk:"is_inactive"`
+	Smoothing          *rateLimitSmoothingModel         `tfsdk:"smoothing"`
+	Monitor            *monitorModel                    `tfsdk:"monitor"`
+	AccessRights       map[string]accessDefinitionModel `tfsdk:"access_rights"`
+	MetaData           map[string]types.String          `tfsdk:"meta_data"`
+	Tags               []types.String                   `tfsdk:"tags"`
+	ApplyPolicies      []types.String                   `tfsdk:"apply_policies"`
 }
 
 func (r *keyResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -33,26 +63,92 @@ func (r *keyResource) Metadata(ctx context.Context, req resource.MetadataRequest
 }
 
 func (r *keyResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
-	resp.Schema = schema.Schema{
-		Attributes: map[string]schema.Attribute{
-			"hashed": schema.BoolAttribute{
-				Description: "Indicates if the key is hashed.",
-				Optional:    true,
+	attributes := map[string]schema.Attribute{
+		"hashed": schema.BoolAttribute{
+			Description: "Indicates if the key is hashed.",
+			Optional:    true,
+			Computed:    true,
+			Default:     booldefault.StaticBool(false),
+			PlanModifiers: []planmodifier.Bool{
+				boolplanmodifier.RequiresReplace(),
 			},
-			"key_config": schema.StringAttribute{
-				Description: "The key config json string",
-				Required:    true,
+		},
+		"key_config": schema.StringAttribute{
+			Description: "The key config json string. Conflicts with the typed key attributes.",
+			CustomType:  jsonStringType{},
+			Optional:    true,
+		},
+		"key": schema.StringAttribute{
+			Description: "The key. Sensitive, as it is the custom_key when that is set.",
+			Computed:    true,
+			Sensitive:   true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
 			},
-			"key": schema.StringAttribute{
-				Description: "The key.",
-				Computed:    true,
+		},
+		"key_hash": schema.StringAttribute{
+			Description: "The key hash.",
+			Computed:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
 			},
-			"key_hash": schema.StringAttribute{
-				Description: "The key hash.",
-				Computed:    true,
+		},
+		"custom_key": schema.StringAttribute{
+			Description: "A custom key to create instead of a generated one, e.g. to keep the value of a migrated key.",
+			Optional:    true,
+			Sensitive:   true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
 			},
 		},
 	}
+	for name, attribute := range keySessionAttributes() {
+		attributes[name] = attribute
+	}
+
+	resp.Schema = schema.Schema{
+		Attributes: attributes,
+	}
+}
+
+func (r *keyResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var keyConfig attr.Value
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("key_config"), &keyConfig)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The key is described either by key_config or by the typed attributes.
+	hasSessionAttributes := false
+	for _, name := range keySessionAttributeNames {
+		var value attr.Value
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(name), &value)...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if value.IsNull() {
+			continue
+		}
+		hasSessionAttributes = true
+
+		if !keyConfig.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(name),
+				"Conflicting key attributes",
+				"The attribute "+name+" cannot be combined with key_config.",
+			)
+		}
+	}
+
+	if keyConfig.IsNull() && !hasSessionAttributes {
+		resp.Diagnostics.AddError(
+			"Missing key attributes",
+			"Either key_config or at least one of the typed key attributes must be set.",
+		)
+	}
 }
 
 func (r *keyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -89,8 +185,7 @@ func (r *keyResource) Create(ctx context.Context, req resource.CreateRequest, re
 		return
 	}
 
-	var key map[string]any
-	err := json.Unmarshal([]byte(data.KeyConfig.ValueString()), &key)
+	key, err := keyFromModel(data, client.SessionState{})
 
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -101,12 +196,17 @@ func (r *keyResource) Create(ctx context.Context, req resource.CreateRequest, re
 	}
 
 	// Create API call logic
-	createKeyResponse, err := r.client.CreateKeyWithHashed(key, data.Hashed.ValueBool())
+	var createKeyResponse client.ApiModifyKeySuccess
+	if data.CustomKey.IsNull() {
+		createKeyResponse, err = r.client.CreateKeyWithHashedContext(ctx, key, data.Hashed.ValueBool())
+	} else {
+		createKeyResponse, err = r.client.CreateCustomKeyWithHashedContext(ctx, data.CustomKey.ValueString(), key, data.Hashed.ValueBool())
+	}
 
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error creating key",
-			"Could not create key, unexpected error: "+err.Error(),
+			clientErrorDetail("Could not create key", err),
 		)
 		return
 	}
@@ -133,15 +233,62 @@ func (r *keyResource) Read(ctx context.Context, req resource.ReadRequest, resp *
 	if data.Hashed.ValueBool() {
 		keyId = data.KeyHash.ValueString()
 	}
-	_, err := r.client.GetKeyWithHashed(keyId, data.Hashed.ValueBool())
+	session, err := r.client.GetKeyWithHashedContext(ctx, keyId, data.Hashed.ValueBool())
 	if err != nil {
-		// TODO: check for 404 Not Found error and remove from state
+		if client.IsNotFound(err) {
+			// The key was deleted outside of Terraform, recreate it on the next apply.
+			resp.State.RemoveResource(ctx)
+			return
+		}
 		resp.Diagnostics.AddError(
 			"Error reading key",
-			"Could not read key, unexpected error: "+err.Error(),
+			clientErrorDetail("Could not read key", err),
+		)
+		return
+	}
+
+	if data.KeyConfig.IsNull() && data.hasSessionAttributes() {
+		sessionState, err := session.SessionState()
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error reading key",
+				"Could not decode key session, unexpected error: "+err.Error(),
+			)
+			return
+		}
+		refreshKeySession(&data, sessionState)
+
+		// Save updated data into Terraform state
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		return
+	}
+
+	// An imported key has no key_config yet, take the whole live session.
+	var refreshedKeyConfig any
+	if data.KeyConfig.IsNull() {
+		refreshedKeyConfig = stripServerManagedFields(map[string]any(session))
+	} else {
+		var keyConfig map[string]any
+		err = json.Unmarshal([]byte(data.KeyConfig.ValueString()), &keyConfig)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error parsing key JSON",
+				"Could not parse key JSON, unexpected error: "+err.Error(),
+			)
+			return
+		}
+		refreshedKeyConfig = refreshKeyConfig(keyConfig, session)
+	}
+
+	rb, err := json.Marshal(refreshedKeyConfig)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error encoding key JSON",
+			"Could not encode key JSON, unexpected error: "+err.Error(),
 		)
 		return
 	}
+	data.KeyConfig = newJsonStringValue(string(rb))
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -153,12 +300,41 @@ func (r *keyResource) Update(ctx context.Context, req resource.UpdateRequest, re
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
 
+	// The key and its hash never change in place. A key imported by its hash
+	// has no key, which is planned as unknown, so take both from the state.
+	var state keyResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	var key map[string]any
-	err := json.Unmarshal([]byte(data.KeyConfig.ValueString()), &key)
+	data.Key = state.Key
+	data.KeyHash = state.KeyHash
+
+	keyId := data.Key.ValueString()
+	if data.Hashed.ValueBool() {
+		keyId = data.KeyHash.ValueString()
+	}
+
+	// The update replaces the whole session, so the typed key attributes are
+	// set onto the live one.
+	var live client.SessionState
+	if data.KeyConfig.IsNull() {
+		liveKey, err := r.client.GetKeyWithHashedContext(ctx, keyId, data.Hashed.ValueBool())
+		if err == nil {
+			live, err = liveKey.SessionState()
+		}
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error updating key",
+				clientErrorDetail("Could not read key", err),
+			)
+			return
+		}
+	}
+
+	key, err := keyFromModel(data, live)
 
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -169,15 +345,11 @@ func (r *keyResource) Update(ctx context.Context, req resource.UpdateRequest, re
 	}
 
 	// Update API call logic
-	keyId := data.Key.ValueString()
-	if data.Hashed.ValueBool() {
-		keyId = data.KeyHash.ValueString()
-	}
-	_, err = r.client.UpdateKeyWithHashed(keyId, key, data.Hashed.ValueBool())
+	_, err = r.client.UpdateKeyWithHashedContext(ctx, keyId, key, data.Hashed.ValueBool())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error updating key",
-			"Could not update key, unexpected error: "+err.Error(),
+			clientErrorDetail("Could not update key", err),
 		)
 		return
 	}
@@ -195,28 +367,55 @@ func (r *keyResource) Delete(ctx context.Context, req resource.DeleteRequest, re
 		return
 	}
 
-	var key map[string]any
-	err := json.Unmarshal([]byte(data.KeyConfig.ValueString()), &key)
-
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error parsing key JSON",
-			"Could not parse key JSON, unexpected error: "+err.Error(),
-		)
-		return
-	}
-
 	// Delete API call logic
 	keyId := data.Key.ValueString()
 	if data.Hashed.ValueBool() {
 		keyId = data.KeyHash.ValueString()
 	}
-	err = r.client.DeleteKeyWithHashed(keyId, data.Hashed.ValueBool())
+	err := r.client.DeleteKeyWithHashedContext(ctx, keyId, data.Hashed.ValueBool())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error deleting key",
-			"Could not delete key, unexpected error: "+err.Error(),
+			clientErrorDetail("Could not delete key", err),
 		)
 		return
 	}
 }
+
+func (r *keyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Plain keys are imported by the key itself, hashed keys by their hash
+	// prefixed with "hash:" since the key cannot be recovered from it.
+	if keyHash, ok := strings.CutPrefix(req.ID, hashedKeyImportPrefix); ok {
+		if keyHash == "" {
+			resp.Diagnostics.AddError(
+				"Invalid import ID",
+				"Expected an import ID of the form <key> or hash:<key_hash>, got: "+req.ID,
+			)
+			return
+		}
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("hashed"), true)...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("key_hash"), keyHash)...)
+		return
+	}
+
+	// The gateway only reports key hashes when creating hashed keys, so a
+	// plain key has an empty key_hash, as when it is created.
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("hashed"), false)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("key"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("key_hash"), "")...)
+}
+
+// keyFromModel builds the key session from key_config or, when it is not set,
+// from the typed key attributes set onto the live session.
+func keyFromModel(data keyResourceModel, live client.SessionState) (client.Key, error) {
+	if data.KeyConfig.IsNull() {
+		return sessionStateFromModel(data, live).Key()
+	}
+
+	var key client.Key
+	err := json.Unmarshal([]byte(data.KeyConfig.ValueString()), &key)
+	if err != nil {
+		return nil, err
+	}
+	return key, nil
+}
@@ -1,9 +1,19 @@
 package provider
 
 import (
+	"context"
+	"fmt"
+	"regexp"
+	"terraform-provider-tykgateway/client"
+	"terraform-provider-tykgateway/internal/provider/generated"
 	"testing"
 
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
 )
 
 func TestAccKeyResource(t *testing.T) {
@@ -35,3 +45,460 @@ resource "tykgateway_key" "key1" {
 		},
 	})
 }
+
+func TestAccKeyResourceImport(t *testing.T) {
+
+	t.Setenv("TF_ACC", "1")
+
+	config := providerConfig + `
+resource "tykgateway_key" "key1" {
+  hashed = true
+  key_config = jsonencode(
+	{
+		"org_id": "default",
+		"access_rights": {
+			"httpbin-api": {
+				"api_id": "httpbin-api",
+				"api_name": "Httpbin API"
+			}
+		}
+	})
+}`
+	imported := testAccCreateKey(t, "imported-hashed-key", true)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+			},
+			{
+				ResourceName:      "tykgateway_key.key1",
+				ImportState:       true,
+				ImportStateIdFunc: testAccHashedKeyImportStateId("tykgateway_key.key1"),
+				ImportStateVerify: true,
+				// The key cannot be recovered from its hash, and the imported
+				// key_config carries every field of the live session.
+				ImportStateVerifyIdentifierAttribute: "key_hash",
+				ImportStateVerifyIgnore:              []string{"key", "key_config"},
+			},
+			{
+				// A key imported by its hash is updated right away.
+				Config: config + fmt.Sprintf(`
+import {
+  to = tykgateway_key.imported
+  id = "%s%s"
+}
+
+resource "tykgateway_key" "imported" {
+  hashed = true
+  org_id = "default"
+  rate   = 20
+  per    = 1
+}`, hashedKeyImportPrefix, imported.KeyHash),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("tykgateway_key.imported", "rate", "20"),
+					resource.TestCheckResourceAttr("tykgateway_key.imported", "key_hash", imported.KeyHash),
+					resource.TestCheckNoResourceAttr("tykgateway_key.imported", "key"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKeyResourceHashedReplace(t *testing.T) {
+
+	t.Setenv("TF_ACC", "1")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + testAccHashedKeyConfig(false),
+			},
+			{
+				// Keys are updated by the key or its hash, so flipping hashed
+				// creates a new key.
+				Config: providerConfig + testAccHashedKeyConfig(true),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("tykgateway_key.key1", plancheck.ResourceActionReplace),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("tykgateway_key.key1", "hashed", "true"),
+					resource.TestCheckResourceAttrSet("tykgateway_key.key1", "key_hash"),
+				),
+			},
+		},
+	})
+}
+
+func testAccHashedKeyConfig(hashed bool) string {
+	return fmt.Sprintf(`
+resource "tykgateway_key" "key1" {
+  hashed = %t
+  org_id = "default"
+  rate   = 10
+  per    = 1
+}`, hashed)
+}
+
+func TestAccKeyResourceImportUpdate(t *testing.T) {
+
+	t.Setenv("TF_ACC", "1")
+
+	imported := testAccCreateKey(t, "imported-key", false)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + fmt.Sprintf(`
+import {
+  to = tykgateway_key.key1
+  id = %q
+}
+
+resource "tykgateway_key" "key1" {
+  org_id = "default"
+  rate   = 20
+  per    = 1
+}`, imported.Key),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("tykgateway_key.key1", "rate", "20"),
+					resource.TestCheckResourceAttr("tykgateway_key.key1", "key", imported.Key),
+					resource.TestCheckResourceAttr("tykgateway_key.key1", "key_hash", ""),
+				),
+			},
+		},
+	})
+}
+
+// testAccCreateKey creates a key outside of Terraform, to be imported.
+func testAccCreateKey(t *testing.T, customKey string, hashed bool) client.ApiModifyKeySuccess {
+	t.Helper()
+
+	c, err := client.NewClient(testAccGatewayUrl, testAccApiKey)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	created, err := c.CreateCustomKeyWithHashed(customKey, client.Key{"org_id": "default", "rate": 10, "per": 1}, hashed)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return created
+}
+
+func testAccHashedKeyImportStateId(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("resource not found: %s", resourceName)
+		}
+
+		return hashedKeyImportPrefix + rs.Primary.Attributes["key_hash"], nil
+	}
+}
+
+func TestAccKeyResourceTyped(t *testing.T) {
+
+	t.Setenv("TF_ACC", "1")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+resource "tykgateway_key" "key1" {
+  org_id    = "default"
+  rate      = 100
+  per       = 1
+  quota_max = -1
+  tags      = ["terraform"]
+
+  access_rights = {
+    "httpbin-api" = {
+      api_name = "Httpbin API"
+      endpoints = [
+        {
+          path = "/anything"
+          methods = [
+            {
+              name  = "GET"
+              limit = { rate = 10, per = 1 }
+            }
+          ]
+        }
+      ]
+    }
+  }
+}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("tykgateway_key.key1", "rate", "100"),
+					resource.TestCheckResourceAttr("tykgateway_key.key1", "access_rights.httpbin-api.endpoints.0.methods.0.limit.rate", "10"),
+					resource.TestCheckNoResourceAttr("tykgateway_key.key1", "key_config"),
+					resource.TestCheckResourceAttrSet("tykgateway_key.key1", "key"),
+				),
+			},
+			{
+				Config: providerConfig + `
+resource "tykgateway_key" "key1" {
+  org_id    = "default"
+  rate      = 200
+  per       = 1
+  quota_max = -1
+  tags      = ["terraform"]
+
+  smoothing = {
+    enabled   = true
+    threshold = 100
+    trigger   = 0.5
+    step      = 10
+    delay     = 30
+  }
+
+  meta_data = {
+    owner = "team-a"
+  }
+
+  access_rights = {
+    "httpbin-api" = {
+      api_name = "Httpbin API"
+    }
+  }
+}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("tykgateway_key.key1", "rate", "200"),
+					resource.TestCheckResourceAttr("tykgateway_key.key1", "smoothing.step", "10"),
+					resource.TestCheckResourceAttr("tykgateway_key.key1", "meta_data.owner", "team-a"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKeyResourceConflictingAttributes(t *testing.T) {
+
+	t.Setenv("TF_ACC", "1")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+resource "tykgateway_key" "key1" {
+  rate       = 100
+  key_config = jsonencode({ "org_id": "default" })
+}`,
+				ExpectError: regexp.MustCompile("Conflicting key attributes"),
+			},
+		},
+	})
+}
+
+func TestAccKeyResourceCustomKey(t *testing.T) {
+
+	t.Setenv("TF_ACC", "1")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + testAccCustomKeyConfig("legacy-key-1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("tykgateway_key.key1", "custom_key", "legacy-key-1"),
+					resource.TestCheckResourceAttr("tykgateway_key.key1", "key", "legacy-key-1"),
+					resource.TestCheckResourceAttrSet("tykgateway_key.key1", "key_hash"),
+				),
+			},
+			{
+				Config: providerConfig + testAccCustomKeyConfig("legacy-key-2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("tykgateway_key.key1", "key", "legacy-key-2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKeyResourceKeepsUnknownFields(t *testing.T) {
+
+	t.Setenv("TF_ACC", "1")
+
+	var key string
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + testAccTypedKeyConfig(10),
+				Check: func(s *terraform.State) error {
+					key = s.RootModule().Resources["tykgateway_key.key1"].Primary.Attributes["key"]
+					return nil
+				},
+			},
+			{
+				// A field of a newer gateway survives the update.
+				PreConfig: func() { testAccGateway.UpdateKey(key, "newer_gateway_field", "kept") },
+				Config:    providerConfig + testAccTypedKeyConfig(20),
+				Check: func(s *terraform.State) error {
+					if got := testAccGateway.KeyField(key, "newer_gateway_field"); got != "kept" {
+						return fmt.Errorf("expected newer_gateway_field to be kept, got %v", got)
+					}
+					return nil
+				},
+			},
+		},
+	})
+}
+
+func testAccTypedKeyConfig(rate int) string {
+	return fmt.Sprintf(`
+resource "tykgateway_key" "key1" {
+  org_id = "default"
+  rate   = %d
+  per    = 1
+}`, rate)
+}
+
+func testAccCustomKeyConfig(customKey string) string {
+	return fmt.Sprintf(`
+resource "tykgateway_key" "key1" {
+  hashed     = true
+  custom_key = %q
+  org_id     = "default"
+
+  access_rights = {
+    "httpbin-api" = {
+      api_name = "Httpbin API"
+    }
+  }
+}`, customKey)
+}
+
+func TestAccKeyResourceDrift(t *testing.T) {
+
+	t.Setenv("TF_ACC", "1")
+
+	config := providerConfig + `
+resource "tykgateway_key" "key1" {
+  org_id = "default"
+  rate   = 10
+  per    = 1
+
+  access_rights = {
+    "httpbin-api" = {
+      api_name = "Httpbin API"
+    }
+  }
+}`
+
+	var key string
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: func(s *terraform.State) error {
+					key = s.RootModule().Resources["tykgateway_key.key1"].Primary.Attributes["key"]
+					return nil
+				},
+			},
+			{
+				// Fields that are not configured do not show up as drift.
+				PreConfig: func() { testAccGateway.UpdateKey(key, "quota_max", float64(100)) },
+				Config:    config,
+				PlanOnly:  true,
+			},
+			{
+				PreConfig:          func() { testAccGateway.UpdateKey(key, "rate", float64(99)) },
+				Config:             config,
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: config,
+				Check:  resource.TestCheckResourceAttr("tykgateway_key.key1", "rate", "10"),
+			},
+			{
+				PreConfig:          func() { testAccGateway.DeleteKey(key) },
+				Config:             config,
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				// The deleted key is created again.
+				Config: config,
+				Check:  resource.TestCheckResourceAttrSet("tykgateway_key.key1", "key"),
+			},
+		},
+	})
+}
+
+func TestKeyResourceSchemaSensitive(t *testing.T) {
+	ctx := context.Background()
+	var resp fwresource.SchemaResponse
+	NewKeyResource().Schema(ctx, fwresource.SchemaRequest{}, &resp)
+
+	// The gateway echoes a custom key back as the key.
+	for _, name := range []string{"key", "custom_key"} {
+		if !resp.Schema.Attributes[name].IsSensitive() {
+			t.Errorf("expected %s to be sensitive", name)
+		}
+	}
+}
+
+func TestKeyResourceSchemaMatchesGenerated(t *testing.T) {
+	ctx := context.Background()
+	var resp fwresource.SchemaResponse
+	NewKeyResource().Schema(ctx, fwresource.SchemaRequest{}, &resp)
+
+	testSchemaMatchesGenerated(t, resp.Schema.Attributes, generated.SessionStateResourceSchema(ctx).Attributes)
+}
+
+// testSchemaMatchesGenerated checks that the hand-written attributes have the
+// types of the attributes generated from gateway-swagger.yml with the same
+// path, so that the schemas do not drift apart from the gateway API.
+// Attributes the generated schema does not have, such as key_config, are
+// skipped.
+func testSchemaMatchesGenerated(t *testing.T, attributes map[string]schema.Attribute, generated map[string]schema.Attribute) {
+	t.Helper()
+	ctx := context.Background()
+
+	for name, attribute := range attributes {
+		generatedAttribute, ok := generated[name]
+		if !ok {
+			continue
+		}
+
+		nested, generatedNested := nestedAttributes(attribute), nestedAttributes(generatedAttribute)
+		if nested != nil && generatedNested != nil {
+			t.Run(name, func(t *testing.T) {
+				testSchemaMatchesGenerated(t, nested, generatedNested)
+			})
+			continue
+		}
+
+		got := attribute.GetType().TerraformType(ctx)
+		want := generatedAttribute.GetType().TerraformType(ctx)
+		if !got.Equal(want) {
+			t.Errorf("attribute %s is %s, the gateway API has %s", name, got, want)
+		}
+	}
+}
+
+// nestedAttributes returns the attributes nested in a map, list or single
+// nested attribute, so that the collections are compared attribute by
+// attribute.
+func nestedAttributes(attribute schema.Attribute) map[string]schema.Attribute {
+	switch attribute := attribute.(type) {
+	case schema.MapNestedAttribute:
+		return attribute.NestedObject.Attributes
+	case schema.ListNestedAttribute:
+		return attribute.NestedObject.Attributes
+	case schema.SingleNestedAttribute:
+		return attribute.Attributes
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,308 @@
+package provider
+
+import (
+	"encoding/json"
+	"terraform-provider-tykgateway/client"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// keySessionAttributeNames are the typed key attributes, which are an
+// alternative to key_config.
+var keySessionAttributeNames = []string{
+	"org_id",
+	"alias",
+	"rate",
+	"per",
+	"throttle_interval",
+	"throttle_retry_limit",
+	"max_query_depth",
+	"quota_max",
+	"quota_renewal_rate",
+	"expires",
+	"is_inactive",
+	"smoothing",
+	"monitor",
+	"access_rights",
+	"meta_data",
+	"tags",
+	"apply_policies",
+}
+
+type rateLimitSmoothingModel struct {
+	Enabled   types.Bool    `tfsdk:"enabled"`
+	Threshold types.Int64   `tfsdk:"threshold"`
+	Trigger   types.Float64 `tfsdk:"trigger"`
+	Step      types.Int64   `tfsdk:"step"`
+	Delay     types.Int64   `tfsdk:"delay"`
+}
+
+type monitorModel struct {
+	TriggerLimits []types.Float64 `tfsdk:"trigger_limits"`
+}
+
+func keySessionAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"org_id": schema.StringAttribute{
+			Description: "The organisation the key belongs to.",
+			Optional:    true,
+		},
+		"alias": schema.StringAttribute{
+			Description: "A human readable name for the key, shown in analytics and logs.",
+			Optional:    true,
+		},
+		"rate": schema.Float64Attribute{
+			Description: "The allowed number of requests per interval.",
+			Optional:    true,
+			Validators: []validator.Float64{
+				float64validator.AtLeast(0),
+			},
+		},
+		"per": schema.Float64Attribute{
+			Description: "The interval in seconds at which the rate limit is enforced.",
+			Optional:    true,
+			Validators: []validator.Float64{
+				float64validator.AtLeast(0),
+			},
+		},
+		"throttle_interval": schema.Float64Attribute{
+			Description: "The interval in seconds between throttled retries.",
+			Optional:    true,
+			Validators: []validator.Float64{
+				float64validator.AtLeast(0),
+			},
+		},
+		"throttle_retry_limit": schema.Int64Attribute{
+			Description: "The number of throttled retries.",
+			Optional:    true,
+			Validators: []validator.Int64{
+				int64validator.AtLeast(0),
+			},
+		},
+		"max_query_depth": schema.Int64Attribute{
+			Description: "The maximum GraphQL query depth.",
+			Optional:    true,
+		},
+		"quota_max": schema.Int64Attribute{
+			Description: "The maximum number of requests per quota period, -1 for unlimited.",
+			Optional:    true,
+			Validators: []validator.Int64{
+				int64validator.AtLeast(-1),
+			},
+		},
+		"quota_renewal_rate": schema.Int64Attribute{
+			Description: "The quota period in seconds.",
+			Optional:    true,
+			Validators: []validator.Int64{
+				int64validator.AtLeast(0),
+			},
+		},
+		"expires": schema.Int64Attribute{
+			Description: "The unix timestamp at which the key expires, 0 for never.",
+			Optional:    true,
+			Validators: []validator.Int64{
+				int64validator.AtLeast(0),
+			},
+		},
+		"is_inactive": schema.BoolAttribute{
+			Description: "Disables the key without deleting it.",
+			Optional:    true,
+		},
+		"smoothing": schema.SingleNestedAttribute{
+			Description: "Rate limit smoothing settings.",
+			Optional:    true,
+			Attributes: map[string]schema.Attribute{
+				"enabled": schema.BoolAttribute{
+					Description: "Indicates if rate limit smoothing is active.",
+					Required:    true,
+				},
+				"threshold": schema.Int64Attribute{
+					Description: "The request rate beyond which smoothing is applied.",
+					Optional:    true,
+					Validators: []validator.Int64{
+						int64validator.AtLeast(0),
+					},
+				},
+				"trigger": schema.Float64Attribute{
+					Description: "The fraction of the step at which a smoothing event is emitted.",
+					Optional:    true,
+					Validators: []validator.Float64{
+						float64validator.AtLeast(0),
+					},
+				},
+				"step": schema.Int64Attribute{
+					Description: "The increment by which the allowance is changed on each smoothing event.",
+					Optional:    true,
+					Validators: []validator.Int64{
+						int64validator.AtLeast(0),
+					},
+				},
+				"delay": schema.Int64Attribute{
+					Description: "The hold-off in seconds between smoothing events.",
+					Optional:    true,
+					Validators: []validator.Int64{
+						int64validator.AtLeast(0),
+					},
+				},
+			},
+		},
+		"monitor": schema.SingleNestedAttribute{
+			Description: "Quota usage monitoring.",
+			Optional:    true,
+			Attributes: map[string]schema.Attribute{
+				"trigger_limits": schema.ListAttribute{
+					Description: "Percentages of the quota at which monitoring events fire.",
+					ElementType: types.Float64Type,
+					Required:    true,
+				},
+			},
+		},
+		"access_rights": accessRightsAttribute(),
+		"meta_data": schema.MapAttribute{
+			Description: "Metadata attached to the key.",
+			ElementType: types.StringType,
+			Optional:    true,
+		},
+		"tags": schema.ListAttribute{
+			Description: "Tags attached to the key.",
+			ElementType: types.StringType,
+			Optional:    true,
+		},
+		"apply_policies": schema.ListAttribute{
+			Description: "IDs of the policies applied to the key.",
+			ElementType: types.StringType,
+			Optional:    true,
+		},
+	}
+}
+
+// hasSessionAttributes reports whether any typed key attribute is set.
+func (m keyResourceModel) hasSessionAttributes() bool {
+	return !m.OrgId.IsNull() || !m.Alias.IsNull() || !m.Rate.IsNull() || !m.Per.IsNull() ||
+		!m.ThrottleInterval.IsNull() || !m.ThrottleRetryLimit.IsNull() || !m.MaxQueryDepth.IsNull() ||
+		!m.QuotaMax.IsNull() || !m.QuotaRenewalRate.IsNull() || !m.Expires.IsNull() || !m.IsInactive.IsNull() ||
+		m.Smoothing != nil || m.Monitor != nil || m.AccessRights != nil || m.MetaData != nil ||
+		m.Tags != nil || m.ApplyPolicies != nil
+}
+
+// sessionStateFromModel sets the typed key attributes onto the live session,
+// so that the fields Terraform does not model, such as the quota usage, and
+// those of newer gateways survive an update.
+func sessionStateFromModel(data keyResourceModel, live client.SessionState) client.SessionState {
+	sessionState := live
+	sessionState.OrgID = data.OrgId.ValueString()
+	sessionState.Alias = data.Alias.ValueString()
+	sessionState.Rate = data.Rate.ValueFloat64()
+	sessionState.Per = data.Per.ValueFloat64()
+	sessionState.ThrottleInterval = data.ThrottleInterval.ValueFloat64()
+	sessionState.ThrottleRetryLimit = int(data.ThrottleRetryLimit.ValueInt64())
+	sessionState.MaxQueryDepth = int(data.MaxQueryDepth.ValueInt64())
+	sessionState.QuotaMax = data.QuotaMax.ValueInt64()
+	sessionState.QuotaRenewalRate = data.QuotaRenewalRate.ValueInt64()
+	sessionState.Expires = data.Expires.ValueInt64()
+	sessionState.IsInactive = data.IsInactive.ValueBool()
+	sessionState.AccessRights = accessRightsToClient(data.AccessRights, live.AccessRights)
+	sessionState.Tags = stringsToClient(data.Tags)
+	sessionState.ApplyPolicies = stringsToClient(data.ApplyPolicies)
+	sessionState.Smoothing = nil
+	sessionState.Monitor = nil
+	sessionState.MetaData = nil
+
+	// The gateway expects allowance and rate to carry the same value.
+	sessionState.Allowance = sessionState.Rate
+
+	if data.Smoothing != nil {
+		sessionState.Smoothing = &client.RateLimitSmoothing{
+			Enabled:   data.Smoothing.Enabled.ValueBool(),
+			Threshold: data.Smoothing.Threshold.ValueInt64(),
+			Trigger:   data.Smoothing.Trigger.ValueFloat64(),
+			Step:      data.Smoothing.Step.ValueInt64(),
+			Delay:     data.Smoothing.Delay.ValueInt64(),
+		}
+	}
+
+	if data.Monitor != nil {
+		sessionState.Monitor = &client.Monitor{}
+		for _, triggerLimit := range data.Monitor.TriggerLimits {
+			sessionState.Monitor.TriggerLimits = append(sessionState.Monitor.TriggerLimits, triggerLimit.ValueFloat64())
+		}
+	}
+
+	if data.MetaData != nil {
+		sessionState.MetaData = make(map[string]any, len(data.MetaData))
+		for key, value := range data.MetaData {
+			sessionState.MetaData[key] = value.ValueString()
+		}
+	}
+
+	return sessionState
+}
+
+// refreshKeySession refreshes the typed key attributes set in data from the
+// live session.
+func refreshKeySession(data *keyResourceModel, session client.SessionState) {
+	data.OrgId = refreshString(data.OrgId, session.OrgID)
+	data.Alias = refreshString(data.Alias, session.Alias)
+	data.Rate = refreshFloat64(data.Rate, session.Rate)
+	data.Per = refreshFloat64(data.Per, session.Per)
+	data.ThrottleInterval = refreshFloat64(data.ThrottleInterval, session.ThrottleInterval)
+	data.ThrottleRetryLimit = refreshInt64(data.ThrottleRetryLimit, int64(session.ThrottleRetryLimit))
+	data.MaxQueryDepth = refreshInt64(data.MaxQueryDepth, int64(session.MaxQueryDepth))
+	data.QuotaMax = refreshInt64(data.QuotaMax, session.QuotaMax)
+	data.QuotaRenewalRate = refreshInt64(data.QuotaRenewalRate, session.QuotaRenewalRate)
+	data.Expires = refreshInt64(data.Expires, session.Expires)
+	data.IsInactive = refreshBool(data.IsInactive, session.IsInactive)
+	data.AccessRights = refreshAccessRights(data.AccessRights, session.AccessRights)
+	data.Tags = refreshStrings(data.Tags, session.Tags)
+	data.ApplyPolicies = refreshStrings(data.ApplyPolicies, session.ApplyPolicies)
+
+	if data.Smoothing != nil {
+		var smoothing client.RateLimitSmoothing
+		if session.Smoothing != nil {
+			smoothing = *session.Smoothing
+		}
+		data.Smoothing = &rateLimitSmoothingModel{
+			Enabled:   refreshBool(data.Smoothing.Enabled, smoothing.Enabled),
+			Threshold: refreshInt64(data.Smoothing.Threshold, smoothing.Threshold),
+			Trigger:   refreshFloat64(data.Smoothing.Trigger, smoothing.Trigger),
+			Step:      refreshInt64(data.Smoothing.Step, smoothing.Step),
+			Delay:     refreshInt64(data.Smoothing.Delay, smoothing.Delay),
+		}
+	}
+
+	if data.Monitor != nil {
+		data.Monitor = &monitorModel{
+			TriggerLimits: []types.Float64{},
+		}
+		if session.Monitor != nil {
+			for _, triggerLimit := range session.Monitor.TriggerLimits {
+				data.Monitor.TriggerLimits = append(data.Monitor.TriggerLimits, types.Float64Value(triggerLimit))
+			}
+		}
+	}
+
+	if data.MetaData != nil {
+		data.MetaData = make(map[string]types.String, len(session.MetaData))
+		for key, value := range session.MetaData {
+			data.MetaData[key] = types.StringValue(metaDataString(value))
+		}
+	}
+}
+
+// metaDataString renders a meta_data value set outside of Terraform, which
+// need not be a string.
+func metaDataString(value any) string {
+	if value, ok := value.(string); ok {
+		return value
+	}
+
+	rb, err := json.Marshal(value)
+	if err != nil {
+		return ""
+	}
+	return string(rb)
+}
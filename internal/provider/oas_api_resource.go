@@ -0,0 +1,283 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"terraform-provider-tykgateway/client"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &oasApiResource{}
+var _ resource.ResourceWithConfigure = &oasApiResource{}
+var _ resource.ResourceWithImportState = &oasApiResource{}
+
+const xTykAPIGateway = "x-tyk-api-gateway"
+
+func NewOasApiResource() resource.Resource {
+	return &oasApiResource{}
+}
+
+type oasApiResource struct {
+	client *client.Client
+}
+
+type oasApiResourceModel struct {
+	ApiId         types.String    `tfsdk:"api_id"`
+	ListenPath    types.String    `tfsdk:"listen_path"`
+	OasDefinition jsonStringValue `tfsdk:"oas_definition"`
+	WaitUntilLive types.Bool      `tfsdk:"wait_until_live"`
+}
+
+func (r *oasApiResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_oas_api"
+}
+
+func (r *oasApiResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a Tyk OAS API definition.",
+		Attributes: map[string]schema.Attribute{
+			"api_id": schema.StringAttribute{
+				Description: "The API ID assigned by the gateway.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"listen_path": schema.StringAttribute{
+				Description: "The listen path the gateway serves the API on.",
+				Computed:    true,
+			},
+			"oas_definition": schema.StringAttribute{
+				Description: "The OpenAPI 3 document json string, including the x-tyk-api-gateway extension",
+				CustomType:  jsonStringType{},
+				Required:    true,
+			},
+			"wait_until_live": schema.BoolAttribute{
+				Description: "Waits after creating the OAS API until the gateway has loaded it, for at most a minute. The gateway loads new APIs on a reload, see the provider hot_reload setting.",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func (r *oasApiResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			"Expected *client.Client, got something else.",
+		)
+		return
+	}
+
+	r.client = client
+
+	if r.client == nil {
+		resp.Diagnostics.AddError(
+			"Client Not Configured",
+			"The client is not configured, please check your provider configuration.",
+		)
+	}
+}
+
+func (r *oasApiResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data oasApiResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	api, err := parseOasDefinition(data.OasDefinition.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error parsing OAS definition JSON",
+			"Could not parse OAS definition JSON, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Create API call logic
+	createApiResponse, err := r.client.CreateOasApiContext(ctx, api)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating OAS API",
+			clientErrorDetail("Could not create OAS API", err),
+		)
+		return
+	}
+
+	data.ApiId = types.StringValue(createApiResponse.Key)
+
+	// The gateway only serves the API once it has loaded it, so the listen
+	// path is taken from the definition rather than read back.
+	data.ListenPath = types.StringValue(oasListenPath(api))
+
+	// Reload the gateways for the change to take effect
+	reloadAfterChange(ctx, r.client, &resp.Diagnostics)
+
+	// Wait until the gateway serves the OAS API. The state is saved either
+	// way, so that an API that never goes live is tainted instead of lost.
+	if data.WaitUntilLive.ValueBool() {
+		err = r.client.WaitForOasApiContext(ctx, data.ApiId.ValueString(), client.DefaultLiveTimeout)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error waiting for OAS API",
+				clientErrorDetail("The OAS API was created, but the gateway has not loaded it", err),
+			)
+		}
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *oasApiResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data oasApiResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Read API call logic
+	liveApi, err := readLoaded(ctx, r.client,
+		func(ctx context.Context) (client.OasApi, error) {
+			return r.client.GetOasApiContext(ctx, data.ApiId.ValueString())
+		},
+		func(liveApi client.OasApi) bool {
+			oasDefinition, err := refreshJsonDocument(data.OasDefinition, liveApi)
+			return err != nil || !jsonDocumentsEqual(oasDefinition, data.OasDefinition) ||
+				data.ListenPath.ValueString() != oasListenPath(liveApi)
+		},
+	)
+	if err != nil {
+		if client.IsNotFound(err) {
+			// The OAS API was deleted outside of Terraform, recreate it on the next apply.
+			removeIfReloaded(ctx, r.client, &resp.State, &resp.Diagnostics, "OAS API")
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error reading OAS API",
+			clientErrorDetail("Could not read OAS API", err),
+		)
+		return
+	}
+	oasDefinition, err := refreshJsonDocument(data.OasDefinition, liveApi)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error parsing OAS definition JSON",
+			"Could not parse OAS definition JSON, unexpected error: "+err.Error(),
+		)
+		return
+	}
+	data.OasDefinition = oasDefinition
+	data.ListenPath = types.StringValue(oasListenPath(liveApi))
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *oasApiResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data oasApiResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	api, err := parseOasDefinition(data.OasDefinition.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error parsing OAS definition JSON",
+			"Could not parse OAS definition JSON, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Update API call logic
+	_, err = r.client.UpdateOasApiContext(ctx, data.ApiId.ValueString(), api)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating OAS API",
+			clientErrorDetail("Could not update OAS API", err),
+		)
+		return
+	}
+
+	data.ListenPath = types.StringValue(oasListenPath(api))
+
+	// Reload the gateways for the change to take effect
+	reloadAfterChange(ctx, r.client, &resp.Diagnostics)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *oasApiResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data oasApiResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete API call logic
+	err := r.client.DeleteOasApiContext(ctx, data.ApiId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting OAS API",
+			clientErrorDetail("Could not delete OAS API", err),
+		)
+		return
+	}
+
+	// Reload the gateways for the change to take effect
+	reloadAfterChange(ctx, r.client, &resp.Diagnostics)
+}
+
+func (r *oasApiResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("api_id"), req, resp)
+}
+
+// parseOasDefinition decodes an OAS document and checks it carries the
+// x-tyk-api-gateway extension the gateway requires.
+func parseOasDefinition(definition string) (client.OasApi, error) {
+	var api client.OasApi
+	if err := json.Unmarshal([]byte(definition), &api); err != nil {
+		return nil, err
+	}
+
+	if _, ok := api[xTykAPIGateway].(map[string]any); !ok {
+		return nil, fmt.Errorf("the document must contain the %s extension", xTykAPIGateway)
+	}
+
+	return api, nil
+}
+
+func oasListenPath(api client.OasApi) string {
+	extension, _ := api[xTykAPIGateway].(map[string]any)
+	server, _ := extension["server"].(map[string]any)
+	listenPath, _ := server["listenPath"].(map[string]any)
+	value, _ := listenPath["value"].(string)
+	return value
+}
@@ -0,0 +1,88 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccOasApiResource(t *testing.T) {
+
+	t.Setenv("TF_ACC", "1")
+
+	var apiId string
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: hotReloadProviderConfig + testAccOasApiConfig("/httpbin-oas/"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("tykgateway_oas_api.oas1", "api_id"),
+					resource.TestCheckResourceAttr("tykgateway_oas_api.oas1", "listen_path", "/httpbin-oas/"),
+					func(s *terraform.State) error {
+						apiId = s.RootModule().Resources["tykgateway_oas_api.oas1"].Primary.Attributes["api_id"]
+						return nil
+					},
+				),
+			},
+			{
+				Config: hotReloadProviderConfig + testAccOasApiConfig("/httpbin-oas-v2/"),
+				Check:  resource.TestCheckResourceAttr("tykgateway_oas_api.oas1", "listen_path", "/httpbin-oas-v2/"),
+			},
+			{
+				// A change made outside of Terraform shows up as drift.
+				PreConfig:          func() { testAccGateway.UpdateOasApi(apiId, "openapi", "3.1.0") },
+				Config:             hotReloadProviderConfig + testAccOasApiConfig("/httpbin-oas-v2/"),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: hotReloadProviderConfig + testAccOasApiConfig("/httpbin-oas-v2/"),
+				Check:  resource.TestCheckResourceAttr("tykgateway_oas_api.oas1", "listen_path", "/httpbin-oas-v2/"),
+			},
+			{
+				ResourceName:      "tykgateway_oas_api.oas1",
+				ImportState:       true,
+				ImportStateIdFunc: testAccImportStateId("tykgateway_oas_api.oas1", "api_id"),
+				ImportStateVerify: true,
+				// The imported oas_definition carries every field of the live API.
+				ImportStateVerifyIdentifierAttribute: "api_id",
+				ImportStateVerifyIgnore:              []string{"oas_definition"},
+			},
+		},
+	})
+}
+
+func testAccOasApiConfig(listenPath string) string {
+	return fmt.Sprintf(`
+resource "tykgateway_oas_api" "oas1" {
+  oas_definition = jsonencode(
+	{
+		"openapi": "3.0.3",
+		"info": {
+			"title": "Httpbin OAS API",
+			"version": "1.0.0"
+		},
+		"paths": {},
+		"x-tyk-api-gateway": {
+			"info": {
+				"name": "Httpbin OAS API",
+				"state": {
+					"active": true
+				}
+			},
+			"server": {
+				"listenPath": {
+					"value": %q,
+					"strip": true
+				}
+			},
+			"upstream": {
+				"url": "http://httpbin.org"
+			}
+		}
+	})
+}`, listenPath)
+}
@@ -0,0 +1,274 @@
+package provider
+
+import (
+	"context"
+	"strings"
+	"terraform-provider-tykgateway/client"
+	"terraform-provider-tykgateway/internal/provider/generated"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &oauthClientResource{}
+var _ resource.ResourceWithConfigure = &oauthClientResource{}
+var _ resource.ResourceWithImportState = &oauthClientResource{}
+
+func NewOAuthClientResource() resource.Resource {
+	return &oauthClientResource{}
+}
+
+type oauthClientResource struct {
+	client *client.Client
+}
+
+type oauthClientResourceModel struct {
+	ApiId        types.String            `tfsdk:"api_id"`
+	ClientId     types.String            `tfsdk:"client_id"`
+	ClientSecret types.String            `tfsdk:"client_secret"`
+	RedirectUri  types.String            `tfsdk:"redirect_uri"`
+	PolicyId     types.String            `tfsdk:"policy_id"`
+	Description  types.String            `tfsdk:"description"`
+	MetaData     map[string]types.String `tfsdk:"meta_data"`
+}
+
+func (r *oauthClientResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_oauth_client"
+}
+
+func (r *oauthClientResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	// The attributes come from the gateway's NewClientRequest, see
+	// generator_config.yml. Only the plan modifiers and the secret the gateway
+	// generates are added here.
+	resp.Schema = generated.OauthClientResourceSchema(ctx)
+	resp.Schema.Description = "Manages an OAuth client registered with the Tyk Gateway."
+
+	apiId := resp.Schema.Attributes["api_id"].(schema.StringAttribute)
+	apiId.PlanModifiers = []planmodifier.String{
+		stringplanmodifier.RequiresReplace(),
+	}
+	resp.Schema.Attributes["api_id"] = apiId
+
+	clientId := resp.Schema.Attributes["client_id"].(schema.StringAttribute)
+	clientId.PlanModifiers = []planmodifier.String{
+		stringplanmodifier.UseStateForUnknown(),
+		stringplanmodifier.RequiresReplace(),
+	}
+	resp.Schema.Attributes["client_id"] = clientId
+
+	resp.Schema.Attributes["client_secret"] = schema.StringAttribute{
+		Description: "The client secret generated by the gateway.",
+		Computed:    true,
+		Sensitive:   true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+	}
+}
+
+func (r *oauthClientResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			"Expected *client.Client, got something else.",
+		)
+		return
+	}
+
+	r.client = client
+
+	if r.client == nil {
+		resp.Diagnostics.AddError(
+			"Client Not Configured",
+			"The client is not configured, please check your provider configuration.",
+		)
+	}
+}
+
+func (r *oauthClientResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data oauthClientResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create API call logic
+	oauthClient, err := r.client.CreateOAuthClientContext(ctx, oauthClientFromModel(data))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating OAuth client",
+			clientErrorDetail("Could not create OAuth client", err),
+		)
+		return
+	}
+
+	data.ClientId = types.StringValue(oauthClient.ClientID)
+	data.ClientSecret = types.StringValue(oauthClient.ClientSecret)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *oauthClientResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data oauthClientResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Read API call logic
+	oauthClient, err := r.client.GetOAuthClientContext(ctx, data.ApiId.ValueString(), data.ClientId.ValueString())
+	if err != nil {
+		if client.IsNotFound(err) {
+			// The OAuth client was deleted outside of Terraform, recreate it on the next apply.
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error reading OAuth client",
+			clientErrorDetail("Could not read OAuth client", err),
+		)
+		return
+	}
+
+	refreshOAuthClient(&data, oauthClient)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *oauthClientResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data oauthClientResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Update API call logic
+	oauthClient, err := r.client.UpdateOAuthClientContext(ctx, data.ApiId.ValueString(), data.ClientId.ValueString(), oauthClientFromModel(data))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating OAuth client",
+			clientErrorDetail("Could not update OAuth client", err),
+		)
+		return
+	}
+
+	data.ClientSecret = types.StringValue(oauthClient.ClientSecret)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *oauthClientResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data oauthClientResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete API call logic
+	err := r.client.DeleteOAuthClientContext(ctx, data.ApiId.ValueString(), data.ClientId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting OAuth client",
+			clientErrorDetail("Could not delete OAuth client", err),
+		)
+		return
+	}
+}
+
+func (r *oauthClientResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	apiId, clientId, ok := strings.Cut(req.ID, "/")
+	if !ok || apiId == "" || clientId == "" {
+		resp.Diagnostics.AddError(
+			"Invalid import ID",
+			"Expected an import ID of the form <api_id>/<client_id>, got: "+req.ID,
+		)
+		return
+	}
+
+	oauthClient, err := r.client.GetOAuthClientContext(ctx, apiId, clientId)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error importing OAuth client",
+			clientErrorDetail("Could not read OAuth client", err),
+		)
+		return
+	}
+
+	// Read only refreshes the configured attributes, so an imported OAuth
+	// client takes every attribute the gateway reports.
+	data := oauthClientResourceModel{
+		ApiId:        types.StringValue(apiId),
+		ClientId:     types.StringValue(clientId),
+		ClientSecret: types.StringValue(oauthClient.ClientSecret),
+		RedirectUri:  types.StringValue(oauthClient.ClientRedirectURI),
+		PolicyId:     stringFromClient(oauthClient.PolicyID),
+		Description:  stringFromClient(oauthClient.Description),
+	}
+	if len(oauthClient.MetaData) > 0 {
+		data.MetaData = stringMapFromClient(oauthClient.MetaData)
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// refreshOAuthClient refreshes the attributes set in data from the live OAuth
+// client.
+func refreshOAuthClient(data *oauthClientResourceModel, oauthClient client.NewClientRequest) {
+	data.ClientSecret = types.StringValue(oauthClient.ClientSecret)
+	data.RedirectUri = types.StringValue(oauthClient.ClientRedirectURI)
+	data.PolicyId = refreshString(data.PolicyId, oauthClient.PolicyID)
+	data.Description = refreshString(data.Description, oauthClient.Description)
+	if data.MetaData != nil {
+		data.MetaData = stringMapFromClient(oauthClient.MetaData)
+	}
+}
+
+func stringMapFromClient(metaData map[string]string) map[string]types.String {
+	result := make(map[string]types.String, len(metaData))
+	for key, value := range metaData {
+		result[key] = types.StringValue(value)
+	}
+	return result
+}
+
+func oauthClientFromModel(data oauthClientResourceModel) client.NewClientRequest {
+	oauthClient := client.NewClientRequest{
+		APIID:             data.ApiId.ValueString(),
+		ClientID:          data.ClientId.ValueString(),
+		ClientSecret:      data.ClientSecret.ValueString(),
+		ClientRedirectURI: data.RedirectUri.ValueString(),
+		PolicyID:          data.PolicyId.ValueString(),
+		Description:       data.Description.ValueString(),
+	}
+
+	if data.MetaData != nil {
+		oauthClient.MetaData = make(map[string]string, len(data.MetaData))
+		for key, value := range data.MetaData {
+			oauthClient.MetaData[key] = value.ValueString()
+		}
+	}
+
+	return oauthClient
+}
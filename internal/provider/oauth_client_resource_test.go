@@ -0,0 +1,120 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccOAuthClientResource(t *testing.T) {
+
+	t.Setenv("TF_ACC", "1")
+
+	config := `
+resource "tykgateway_oauth_client" "client1" {
+  api_id       = tykgateway_api.oauth.api_id
+  redirect_uri = "https://example.com/callback"
+  description  = "renamed partner client"
+}`
+	var apiId, clientId string
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: hotReloadProviderConfig + testAccOAuthApiConfig + `
+resource "tykgateway_oauth_client" "client1" {
+  api_id       = tykgateway_api.oauth.api_id
+  redirect_uri = "https://example.com/callback"
+  description  = "partner client"
+}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("tykgateway_oauth_client.client1", "client_id"),
+					resource.TestCheckResourceAttrSet("tykgateway_oauth_client.client1", "client_secret"),
+				),
+			},
+			{
+				Config: hotReloadProviderConfig + testAccOAuthApiConfig + config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("tykgateway_oauth_client.client1", "description", "renamed partner client"),
+					func(s *terraform.State) error {
+						attributes := s.RootModule().Resources["tykgateway_oauth_client.client1"].Primary.Attributes
+						apiId, clientId = attributes["api_id"], attributes["client_id"]
+						return nil
+					},
+				),
+			},
+			{
+				PreConfig: func() {
+					testAccGateway.UpdateOAuthClient(apiId, clientId, "redirect_uri", "https://example.com/changed")
+				},
+				Config:             hotReloadProviderConfig + testAccOAuthApiConfig + config,
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: hotReloadProviderConfig + testAccOAuthApiConfig + config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("tykgateway_oauth_client.client1", "redirect_uri", "https://example.com/callback"),
+				),
+			},
+			{
+				ResourceName: "tykgateway_oauth_client.client1",
+				ImportState:  true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					return apiId + "/" + clientId, nil
+				},
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "client_id",
+			},
+		},
+	})
+}
+
+const testAccOAuthApiConfig = `
+resource "tykgateway_api" "oauth" {
+  api_definition = jsonencode(
+	{
+		"name": "OAuth API",
+		"org_id": "default",
+		"use_oauth2": true,
+		"oauth_meta": {
+			"allowed_access_types": ["authorization_code"],
+			"allowed_authorize_types": ["code"],
+			"auth_login_redirect": "https://example.com/login"
+		},
+		"proxy": {
+			"listen_path": "/oauth-api/",
+			"target_url": "http://httpbin.org",
+			"strip_listen_path": true
+		},
+		"version_data": {
+			"not_versioned": true,
+			"versions": {
+				"Default": {
+					"name": "Default"
+				}
+			}
+		}
+	})
+}
+`
+
+func TestOAuthClientResourceSchema(t *testing.T) {
+	ctx := context.Background()
+	var resp fwresource.SchemaResponse
+	NewOAuthClientResource().Schema(ctx, fwresource.SchemaRequest{}, &resp)
+
+	// The schema is generated, so check that the attributes added by hand fit.
+	if diags := resp.Schema.ValidateImplementation(ctx); diags.HasError() {
+		t.Fatalf("invalid schema: %v", diags)
+	}
+	if !resp.Schema.Attributes["client_secret"].IsSensitive() {
+		t.Error("expected client_secret to be sensitive")
+	}
+	if !resp.Schema.Attributes["api_id"].IsRequired() {
+		t.Error("expected api_id to be required")
+	}
+}
@@ -0,0 +1,287 @@
+package provider
+
+import (
+	"context"
+	"terraform-provider-tykgateway/client"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &orgKeyResource{}
+var _ resource.ResourceWithConfigure = &orgKeyResource{}
+
+func NewOrgKeyResource() resource.Resource {
+	return &orgKeyResource{}
+}
+
+type orgKeyResource struct {
+	client *client.Client
+}
+
+type orgKeyResourceModel struct {
+	OrgId            types.String  `tfsdk:"org_id"`
+	Rate             types.Float64 `tfsdk:"rate"`
+	Per              types.Float64 `tfsdk:"per"`
+	QuotaMax         types.Int64   `tfsdk:"quota_max"`
+	QuotaRenewalRate types.Int64   `tfsdk:"quota_renewal_rate"`
+	ResetQuota       types.Bool    `tfsdk:"reset_quota"`
+	QuotaRemaining   types.Int64   `tfsdk:"quota_remaining"`
+	QuotaRenews      types.Int64   `tfsdk:"quota_renews"`
+}
+
+func (r *orgKeyResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_org_key"
+}
+
+func (r *orgKeyResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages organisation-level rate limits and quotas.",
+		Attributes: map[string]schema.Attribute{
+			"org_id": schema.StringAttribute{
+				Description: "The organisation ID, which is also the org key ID.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"rate": schema.Float64Attribute{
+				Description: "The allowed number of requests per interval for the whole organisation.",
+				Optional:    true,
+			},
+			"per": schema.Float64Attribute{
+				Description: "The interval in seconds at which the rate limit is enforced.",
+				Optional:    true,
+			},
+			"quota_max": schema.Int64Attribute{
+				Description: "The maximum number of requests per quota period, -1 for unlimited.",
+				Optional:    true,
+			},
+			"quota_renewal_rate": schema.Int64Attribute{
+				Description: "The quota period in seconds.",
+				Optional:    true,
+			},
+			"reset_quota": schema.BoolAttribute{
+				Description: "Resets the organisation quota in the live quota manager on every create or update.",
+				Optional:    true,
+			},
+			"quota_remaining": schema.Int64Attribute{
+				Description: "The number of requests left in the current quota period, as of the last refresh.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"quota_renews": schema.Int64Attribute{
+				Description: "The unix timestamp at which the quota renews, as of the last refresh.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *orgKeyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			"Expected *client.Client, got something else.",
+		)
+		return
+	}
+
+	r.client = client
+
+	if r.client == nil {
+		resp.Diagnostics.AddError(
+			"Client Not Configured",
+			"The client is not configured, please check your provider configuration.",
+		)
+	}
+}
+
+func (r *orgKeyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data orgKeyResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create API call logic
+	_, err := r.client.CreateOrgKeyContext(ctx, data.OrgId.ValueString(), orgKeyFromModel(data), data.ResetQuota.ValueBool())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating org key",
+			clientErrorDetail("Could not create org key", err),
+		)
+		return
+	}
+
+	orgKey, err := r.client.GetOrgKeyContext(ctx, data.OrgId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading org key",
+			clientErrorDetail("Could not read org key", err),
+		)
+		return
+	}
+	setOrgKeyUsage(&data, orgKey)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *orgKeyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data orgKeyResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Read API call logic
+	orgKey, err := r.client.GetOrgKeyContext(ctx, data.OrgId.ValueString())
+	if err != nil {
+		if client.IsNotFound(err) {
+			// The org key was deleted outside of Terraform, recreate it on the next apply.
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error reading org key",
+			clientErrorDetail("Could not read org key", err),
+		)
+		return
+	}
+
+	session, err := orgKey.SessionState()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading org key",
+			"Could not decode org key session, unexpected error: "+err.Error(),
+		)
+		return
+	}
+	refreshOrgKey(&data, session)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *orgKeyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data orgKeyResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Update API call logic
+	_, err := r.client.UpdateOrgKeyContext(ctx, data.OrgId.ValueString(), orgKeyFromModel(data), data.ResetQuota.ValueBool())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating org key",
+			clientErrorDetail("Could not update org key", err),
+		)
+		return
+	}
+
+	// The usage is planned from the state, which Terraform requires the update
+	// to keep, so it is only read again when it is unknown. The next refresh
+	// picks up the current usage.
+	if data.QuotaRemaining.IsUnknown() || data.QuotaRenews.IsUnknown() {
+		orgKey, err := r.client.GetOrgKeyContext(ctx, data.OrgId.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error reading org key",
+				clientErrorDetail("Could not read org key", err),
+			)
+			return
+		}
+		setOrgKeyUsage(&data, orgKey)
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *orgKeyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data orgKeyResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete API call logic
+	err := r.client.DeleteOrgKeyContext(ctx, data.OrgId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting org key",
+			clientErrorDetail("Could not delete org key", err),
+		)
+		return
+	}
+}
+
+func orgKeyFromModel(data orgKeyResourceModel) client.Key {
+	orgKey := client.Key{
+		"org_id": data.OrgId.ValueString(),
+	}
+
+	// The gateway expects allowance and rate to carry the same value.
+	if !data.Rate.IsNull() {
+		orgKey["rate"] = data.Rate.ValueFloat64()
+		orgKey["allowance"] = data.Rate.ValueFloat64()
+	}
+	if !data.Per.IsNull() {
+		orgKey["per"] = data.Per.ValueFloat64()
+	}
+	if !data.QuotaMax.IsNull() {
+		orgKey["quota_max"] = data.QuotaMax.ValueInt64()
+	}
+	if !data.QuotaRenewalRate.IsNull() {
+		orgKey["quota_renewal_rate"] = data.QuotaRenewalRate.ValueInt64()
+	}
+
+	return orgKey
+}
+
+// refreshOrgKey refreshes the attributes set in data, and the quota usage,
+// from the live org key session.
+func refreshOrgKey(data *orgKeyResourceModel, session client.SessionState) {
+	data.Rate = refreshFloat64(data.Rate, session.Rate)
+	data.Per = refreshFloat64(data.Per, session.Per)
+	data.QuotaMax = refreshInt64(data.QuotaMax, session.QuotaMax)
+	data.QuotaRenewalRate = refreshInt64(data.QuotaRenewalRate, session.QuotaRenewalRate)
+	data.QuotaRemaining = types.Int64Value(session.QuotaRemaining)
+	data.QuotaRenews = types.Int64Value(session.QuotaRenews)
+}
+
+func setOrgKeyUsage(data *orgKeyResourceModel, orgKey client.Key) {
+	quotaRemaining, _ := orgKey["quota_remaining"].(float64)
+	quotaRenews, _ := orgKey["quota_renews"].(float64)
+
+	data.QuotaRemaining = types.Int64Value(int64(quotaRemaining))
+	data.QuotaRenews = types.Int64Value(int64(quotaRenews))
+}
@@ -0,0 +1,81 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccOrgKeyResource(t *testing.T) {
+
+	t.Setenv("TF_ACC", "1")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+resource "tykgateway_org_key" "org1" {
+  org_id             = "terraform-org"
+  rate               = 1000
+  per                = 60
+  quota_max          = 10000
+  quota_renewal_rate = 3600
+}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("tykgateway_org_key.org1", "org_id", "terraform-org"),
+					resource.TestCheckResourceAttrSet("tykgateway_org_key.org1", "quota_remaining"),
+				),
+			},
+			{
+				Config: providerConfig + `
+resource "tykgateway_org_key" "org1" {
+  org_id             = "terraform-org"
+  rate               = 1000
+  per                = 60
+  quota_max          = 20000
+  quota_renewal_rate = 3600
+  reset_quota        = true
+}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("tykgateway_org_key.org1", "quota_max", "20000"),
+				),
+			},
+			{
+				PreConfig:          func() { testAccGateway.UpdateOrgKey("terraform-org", "rate", float64(5)) },
+				Config:             providerConfig + testAccOrgKeyConfig(20000),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				// The quota usage is not planned as unknown on every update.
+				Config: providerConfig + testAccOrgKeyConfig(30000),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectKnownValue("tykgateway_org_key.org1", tfjsonpath.New("quota_remaining"), knownvalue.NotNull()),
+						plancheck.ExpectKnownValue("tykgateway_org_key.org1", tfjsonpath.New("quota_renews"), knownvalue.NotNull()),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("tykgateway_org_key.org1", "rate", "1000"),
+					resource.TestCheckResourceAttr("tykgateway_org_key.org1", "quota_max", "30000"),
+				),
+			},
+		},
+	})
+}
+
+func testAccOrgKeyConfig(quotaMax int) string {
+	return fmt.Sprintf(`
+resource "tykgateway_org_key" "org1" {
+  org_id             = "terraform-org"
+  rate               = 1000
+  per                = 60
+  quota_max          = %d
+  quota_renewal_rate = 3600
+}`, quotaMax)
+}
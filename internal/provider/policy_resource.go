@@ -0,0 +1,448 @@
+package provider
+
+import (
+	"context"
+	"reflect"
+	"terraform-provider-tykgateway/client"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &policyResource{}
+var _ resource.ResourceWithConfigure = &policyResource{}
+var _ resource.ResourceWithImportState = &policyResource{}
+
+func NewPolicyResource() resource.Resource {
+	return &policyResource{}
+}
+
+type policyResource struct {
+	client *client.Client
+}
+
+type policyResourceModel struct {
+	PolicyId           types.String                     `tfsdk:"policy_id"`
+	Name               types.String                     `tfsdk:"name"`
+	OrgId              types.String                     `tfsdk:"org_id"`
+	Active             types.Bool                       `tfsdk:"active"`
+	Rate               types.Float64                    `tfsdk:"rate"`
+	Per                types.Float64                    `tfsdk:"per"`
+	QuotaMax           types.Int64                      `tfsdk:"quota_max"`
+	QuotaRenewalRate   types.Int64                      `tfsdk:"quota_renewal_rate"`
+	ThrottleInterval   types.Float64                    `tfsdk:"throttle_interval"`
+	ThrottleRetryLimit types.Int64                      `tfsdk:"throttle_retry_limit"`
+	AccessRights       map[string]accessDefinitionModel `tfsdk:"access_rights"`
+	Partitions         *policyPartitionsModel           `tfsdk:"partitions"`
+	Tags               []types.String                   `tfsdk:"tags"`
+	MetaData           map[string]types.String          `tfsdk:"meta_data"`
+	KeyExpiresIn       types.Int64                      `tfsdk:"key_expires_in"`
+	IsInactive         types.Bool                       `tfsdk:"is_inactive"`
+}
+
+type policyPartitionsModel struct {
+	Quota      types.Bool `tfsdk:"quota"`
+	RateLimit  types.Bool `tfsdk:"rate_limit"`
+	Complexity types.Bool `tfsdk:"complexity"`
+	Acl        types.Bool `tfsdk:"acl"`
+	PerApi     types.Bool `tfsdk:"per_api"`
+}
+
+func (r *policyResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_policy"
+}
+
+func (r *policyResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a Tyk security policy.",
+		Attributes: map[string]schema.Attribute{
+			"policy_id": schema.StringAttribute{
+				Description: "The policy ID assigned by the gateway.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The policy name.",
+				Optional:    true,
+			},
+			"org_id": schema.StringAttribute{
+				Description: "The organisation the policy belongs to.",
+				Optional:    true,
+			},
+			"active": schema.BoolAttribute{
+				Description: "Indicates if the policy is active.",
+				Optional:    true,
+			},
+			"rate": schema.Float64Attribute{
+				Description: "The allowed number of requests per interval.",
+				Optional:    true,
+			},
+			"per": schema.Float64Attribute{
+				Description: "The interval in seconds at which the rate limit is enforced.",
+				Optional:    true,
+			},
+			"quota_max": schema.Int64Attribute{
+				Description: "The maximum number of requests per quota period, -1 for unlimited.",
+				Optional:    true,
+			},
+			"quota_renewal_rate": schema.Int64Attribute{
+				Description: "The quota period in seconds.",
+				Optional:    true,
+			},
+			"throttle_interval": schema.Float64Attribute{
+				Description: "The interval in seconds between throttled retries.",
+				Optional:    true,
+			},
+			"throttle_retry_limit": schema.Int64Attribute{
+				Description: "The number of throttled retries.",
+				Optional:    true,
+			},
+			"access_rights": accessRightsAttribute(),
+			"partitions": schema.SingleNestedAttribute{
+				Description: "Which parts of the policy are applied to keys.",
+				Optional:    true,
+				Attributes: map[string]schema.Attribute{
+					"quota": schema.BoolAttribute{
+						Description: "Applies the policy quota.",
+						Optional:    true,
+					},
+					"rate_limit": schema.BoolAttribute{
+						Description: "Applies the policy rate limit.",
+						Optional:    true,
+					},
+					"complexity": schema.BoolAttribute{
+						Description: "Applies the policy query complexity limits.",
+						Optional:    true,
+					},
+					"acl": schema.BoolAttribute{
+						Description: "Applies the policy access rights.",
+						Optional:    true,
+					},
+					"per_api": schema.BoolAttribute{
+						Description: "Applies limits per API instead of globally.",
+						Optional:    true,
+					},
+				},
+			},
+			"tags": schema.ListAttribute{
+				Description: "Tags added to keys using the policy.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"meta_data": schema.MapAttribute{
+				Description: "Metadata added to keys using the policy.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"key_expires_in": schema.Int64Attribute{
+				Description: "The number of seconds after which keys using the policy expire.",
+				Optional:    true,
+			},
+			"is_inactive": schema.BoolAttribute{
+				Description: "Disables keys using the policy.",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func (r *policyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			"Expected *client.Client, got something else.",
+		)
+		return
+	}
+
+	r.client = client
+
+	if r.client == nil {
+		resp.Diagnostics.AddError(
+			"Client Not Configured",
+			"The client is not configured, please check your provider configuration.",
+		)
+	}
+}
+
+func (r *policyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data policyResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create API call logic
+	createPolicyResponse, err := r.client.CreatePolicyContext(ctx, policyFromModel(data, client.Policy{}))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating policy",
+			clientErrorDetail("Could not create policy", err),
+		)
+		return
+	}
+
+	data.PolicyId = types.StringValue(createPolicyResponse.Key)
+
+	// Reload the gateways for the change to take effect
+	reloadAfterChange(ctx, r.client, &resp.Diagnostics)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *policyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data policyResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Read API call logic
+	livePolicy, err := readLoaded(ctx, r.client,
+		func(ctx context.Context) (client.Policy, error) {
+			return r.client.GetPolicyContext(ctx, data.PolicyId.ValueString())
+		},
+		func(livePolicy client.Policy) bool {
+			refreshed := data
+			refreshPolicy(&refreshed, livePolicy)
+			return !reflect.DeepEqual(refreshed, data)
+		},
+	)
+	if err != nil {
+		if client.IsNotFound(err) {
+			// The policy was deleted outside of Terraform, recreate it on the next apply.
+			removeIfReloaded(ctx, r.client, &resp.State, &resp.Diagnostics, "policy")
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error reading policy",
+			clientErrorDetail("Could not read policy", err),
+		)
+		return
+	}
+	refreshPolicy(&data, livePolicy)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *policyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data policyResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The update replaces the whole policy, so it is built on the live one.
+	// A policy the gateway has not loaded yet is built from the plan alone.
+	live, err := r.client.GetPolicyContext(ctx, data.PolicyId.ValueString())
+	if err != nil && !client.IsNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Error updating policy",
+			clientErrorDetail("Could not read policy", err),
+		)
+		return
+	}
+
+	// Update API call logic
+	_, err = r.client.UpdatePolicyContext(ctx, data.PolicyId.ValueString(), policyFromModel(data, live))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating policy",
+			clientErrorDetail("Could not update policy", err),
+		)
+		return
+	}
+
+	// Reload the gateways for the change to take effect
+	reloadAfterChange(ctx, r.client, &resp.Diagnostics)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *policyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data policyResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete API call logic
+	err := r.client.DeletePolicyContext(ctx, data.PolicyId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting policy",
+			clientErrorDetail("Could not delete policy", err),
+		)
+		return
+	}
+
+	// Reload the gateways for the change to take effect
+	reloadAfterChange(ctx, r.client, &resp.Diagnostics)
+}
+
+func (r *policyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	policy, err := readLoaded(ctx, r.client,
+		func(ctx context.Context) (client.Policy, error) {
+			return r.client.GetPolicyContext(ctx, req.ID)
+		},
+		func(policy client.Policy) bool { return false },
+	)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error importing policy",
+			clientErrorDetail("Could not read policy", err),
+		)
+		return
+	}
+
+	// Read only refreshes the configured attributes, so an imported policy
+	// takes every attribute the gateway reports.
+	data := policyModelFromClient(policy)
+	data.PolicyId = types.StringValue(req.ID)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// policyFromModel sets the policy attributes onto the live policy, so that
+// the fields Terraform does not model, and those of newer gateways, survive an
+// update.
+func policyFromModel(data policyResourceModel, live client.Policy) client.Policy {
+	policy := live
+	policy.ID = data.PolicyId.ValueString()
+	policy.Name = data.Name.ValueString()
+	policy.OrgID = data.OrgId.ValueString()
+	policy.Active = data.Active.ValueBool()
+	policy.Rate = data.Rate.ValueFloat64()
+	policy.Per = data.Per.ValueFloat64()
+	policy.QuotaMax = data.QuotaMax.ValueInt64()
+	policy.QuotaRenewalRate = data.QuotaRenewalRate.ValueInt64()
+	policy.ThrottleInterval = data.ThrottleInterval.ValueFloat64()
+	policy.ThrottleRetryLimit = int(data.ThrottleRetryLimit.ValueInt64())
+	policy.AccessRights = accessRightsToClient(data.AccessRights, live.AccessRights)
+	policy.Tags = stringsToClient(data.Tags)
+	policy.KeyExpiresIn = data.KeyExpiresIn.ValueInt64()
+	policy.IsInactive = data.IsInactive.ValueBool()
+	policy.Partitions = client.PolicyPartitions{}
+	policy.MetaData = nil
+
+	if data.Partitions != nil {
+		policy.Partitions = client.PolicyPartitions{
+			Quota:      data.Partitions.Quota.ValueBool(),
+			RateLimit:  data.Partitions.RateLimit.ValueBool(),
+			Complexity: data.Partitions.Complexity.ValueBool(),
+			Acl:        data.Partitions.Acl.ValueBool(),
+			PerAPI:     data.Partitions.PerApi.ValueBool(),
+		}
+	}
+
+	if data.MetaData != nil {
+		policy.MetaData = make(map[string]any, len(data.MetaData))
+		for key, value := range data.MetaData {
+			policy.MetaData[key] = value.ValueString()
+		}
+	}
+
+	return policy
+}
+
+// refreshPolicy refreshes the attributes set in data from the live policy.
+func refreshPolicy(data *policyResourceModel, policy client.Policy) {
+	data.Name = refreshString(data.Name, policy.Name)
+	data.OrgId = refreshString(data.OrgId, policy.OrgID)
+	data.Active = refreshBool(data.Active, policy.Active)
+	data.Rate = refreshFloat64(data.Rate, policy.Rate)
+	data.Per = refreshFloat64(data.Per, policy.Per)
+	data.QuotaMax = refreshInt64(data.QuotaMax, policy.QuotaMax)
+	data.QuotaRenewalRate = refreshInt64(data.QuotaRenewalRate, policy.QuotaRenewalRate)
+	data.ThrottleInterval = refreshFloat64(data.ThrottleInterval, policy.ThrottleInterval)
+	data.ThrottleRetryLimit = refreshInt64(data.ThrottleRetryLimit, int64(policy.ThrottleRetryLimit))
+	data.AccessRights = refreshAccessRights(data.AccessRights, policy.AccessRights)
+	data.Tags = refreshStrings(data.Tags, policy.Tags)
+	data.KeyExpiresIn = refreshInt64(data.KeyExpiresIn, policy.KeyExpiresIn)
+	data.IsInactive = refreshBool(data.IsInactive, policy.IsInactive)
+
+	if data.Partitions != nil {
+		data.Partitions = &policyPartitionsModel{
+			Quota:      refreshBool(data.Partitions.Quota, policy.Partitions.Quota),
+			RateLimit:  refreshBool(data.Partitions.RateLimit, policy.Partitions.RateLimit),
+			Complexity: refreshBool(data.Partitions.Complexity, policy.Partitions.Complexity),
+			Acl:        refreshBool(data.Partitions.Acl, policy.Partitions.Acl),
+			PerApi:     refreshBool(data.Partitions.PerApi, policy.Partitions.PerAPI),
+		}
+	}
+
+	if data.MetaData != nil {
+		data.MetaData = make(map[string]types.String, len(policy.MetaData))
+		for key, value := range policy.MetaData {
+			data.MetaData[key] = types.StringValue(metaDataString(value))
+		}
+	}
+}
+
+// policyModelFromClient converts a live policy, for imports.
+func policyModelFromClient(policy client.Policy) policyResourceModel {
+	data := policyResourceModel{
+		Name:               stringFromClient(policy.Name),
+		OrgId:              stringFromClient(policy.OrgID),
+		Active:             boolFromClient(policy.Active),
+		Rate:               float64FromClient(policy.Rate),
+		Per:                float64FromClient(policy.Per),
+		QuotaMax:           int64FromClient(policy.QuotaMax),
+		QuotaRenewalRate:   int64FromClient(policy.QuotaRenewalRate),
+		ThrottleInterval:   float64FromClient(policy.ThrottleInterval),
+		ThrottleRetryLimit: int64FromClient(int64(policy.ThrottleRetryLimit)),
+		Tags:               stringsFromClient(policy.Tags),
+		KeyExpiresIn:       int64FromClient(policy.KeyExpiresIn),
+		IsInactive:         boolFromClient(policy.IsInactive),
+	}
+
+	if len(policy.AccessRights) > 0 {
+		data.AccessRights = make(map[string]accessDefinitionModel, len(policy.AccessRights))
+		for apiId, accessDefinition := range policy.AccessRights {
+			data.AccessRights[apiId] = accessDefinitionFromClient(accessDefinition)
+		}
+	}
+
+	if policy.Partitions != (client.PolicyPartitions{}) {
+		data.Partitions = &policyPartitionsModel{
+			Quota:      boolFromClient(policy.Partitions.Quota),
+			RateLimit:  boolFromClient(policy.Partitions.RateLimit),
+			Complexity: boolFromClient(policy.Partitions.Complexity),
+			Acl:        boolFromClient(policy.Partitions.Acl),
+			PerApi:     boolFromClient(policy.Partitions.PerAPI),
+		}
+	}
+
+	if len(policy.MetaData) > 0 {
+		data.MetaData = make(map[string]types.String, len(policy.MetaData))
+		for key, value := range policy.MetaData {
+			data.MetaData[key] = types.StringValue(metaDataString(value))
+		}
+	}
+
+	return data
+}
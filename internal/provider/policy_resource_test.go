@@ -0,0 +1,124 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-tykgateway/internal/provider/generated"
+	"testing"
+
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccPolicyResource(t *testing.T) {
+
+	t.Setenv("TF_ACC", "1")
+
+	var policyId string
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: hotReloadProviderConfig + testAccPolicyConfig(1000),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("tykgateway_policy.policy1", "policy_id"),
+					resource.TestCheckResourceAttr("tykgateway_policy.policy1", "name", "Httpbin Policy"),
+					func(s *terraform.State) error {
+						policyId = s.RootModule().Resources["tykgateway_policy.policy1"].Primary.Attributes["policy_id"]
+						return nil
+					},
+				),
+			},
+			{
+				Config: hotReloadProviderConfig + testAccPolicyConfig(2000),
+				Check:  resource.TestCheckResourceAttr("tykgateway_policy.policy1", "rate", "2000"),
+			},
+			{
+				// A change made outside of Terraform shows up as drift.
+				PreConfig:          func() { testAccGateway.UpdatePolicy(policyId, "rate", float64(5)) },
+				Config:             hotReloadProviderConfig + testAccPolicyConfig(2000),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: hotReloadProviderConfig + testAccPolicyConfig(2000),
+				Check:  resource.TestCheckResourceAttr("tykgateway_policy.policy1", "rate", "2000"),
+			},
+			{
+				ResourceName:                         "tykgateway_policy.policy1",
+				ImportState:                          true,
+				ImportStateIdFunc:                    testAccImportStateId("tykgateway_policy.policy1", "policy_id"),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "policy_id",
+				// api_id defaults to the map key, the gateway reports it set.
+				ImportStateVerifyIgnore: []string{"access_rights.httpbin-api.api_id"},
+			},
+		},
+	})
+}
+
+func TestAccPolicyResourceKeepsUnknownFields(t *testing.T) {
+
+	t.Setenv("TF_ACC", "1")
+
+	var policyId string
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: hotReloadProviderConfig + testAccPolicyConfig(1000),
+				Check: func(s *terraform.State) error {
+					policyId = s.RootModule().Resources["tykgateway_policy.policy1"].Primary.Attributes["policy_id"]
+					return nil
+				},
+			},
+			{
+				// A field of a newer gateway survives the update.
+				PreConfig: func() { testAccGateway.UpdatePolicy(policyId, "newer_gateway_field", "kept") },
+				Config:    hotReloadProviderConfig + testAccPolicyConfig(2000),
+				Check: func(s *terraform.State) error {
+					if got := testAccGateway.PolicyField(policyId, "newer_gateway_field"); got != "kept" {
+						return fmt.Errorf("expected newer_gateway_field to be kept, got %v", got)
+					}
+					return nil
+				},
+			},
+		},
+	})
+}
+
+func testAccPolicyConfig(rate int) string {
+	return fmt.Sprintf(`
+resource "tykgateway_policy" "policy1" {
+  name               = "Httpbin Policy"
+  org_id             = "default"
+  active             = true
+  rate               = %d
+  per                = 60
+  quota_max          = -1
+  quota_renewal_rate = 3600
+  tags               = ["terraform"]
+  meta_data = {
+    team = "platform"
+  }
+  partitions = {
+    acl = true
+  }
+  access_rights = {
+    "httpbin-api" = {
+      api_name = "Httpbin API"
+      versions = ["Default"]
+    }
+  }
+}`, rate)
+}
+
+func TestPolicyResourceSchemaMatchesGenerated(t *testing.T) {
+	ctx := context.Background()
+	var resp fwresource.SchemaResponse
+	NewPolicyResource().Schema(ctx, fwresource.SchemaRequest{}, &resp)
+
+	testSchemaMatchesGenerated(t, resp.Schema.Attributes, generated.PolicyResourceSchema(ctx).Attributes)
+}
@@ -2,13 +2,23 @@ package provider
 
 import (
 	"context"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
 	"terraform-provider-tykgateway/client"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -17,8 +27,23 @@ var _ provider.Provider = (*tykgatewayProvider)(nil)
 
 // hashicupsProviderModel maps provider schema data to a Go type.
 type tykgatewayProviderModel struct {
-	GatewayUrl types.String `tfsdk:"gateway_url"`
-	ApiKey     types.String `tfsdk:"api_key"`
+	GatewayUrl  types.String `tfsdk:"gateway_url"`
+	GatewayUrls types.List   `tfsdk:"gateway_urls"`
+	ApiKey      types.String `tfsdk:"api_key"`
+	MaxRetries  types.Int64  `tfsdk:"max_retries"`
+	MinBackoff  types.String `tfsdk:"min_backoff"`
+	MaxBackoff  types.String `tfsdk:"max_backoff"`
+
+	CACert             types.String `tfsdk:"ca_cert"`
+	ClientCert         types.String `tfsdk:"client_cert"`
+	ClientKey          types.String `tfsdk:"client_key"`
+	TLSServerName      types.String `tfsdk:"tls_server_name"`
+	InsecureSkipVerify types.Bool   `tfsdk:"insecure_skip_verify"`
+
+	HotReload     types.String `tfsdk:"hot_reload"`
+	HotReloadWait types.Bool   `tfsdk:"hot_reload_wait"`
+
+	ReadyTimeout types.String `tfsdk:"ready_timeout"`
 }
 
 func New() func() provider.Provider {
@@ -34,13 +59,78 @@ func (p *tykgatewayProvider) Schema(ctx context.Context, req provider.SchemaRequ
 		Description: "Tyk Gateway provider for managing Tyk Gateway resources.",
 		Attributes: map[string]schema.Attribute{
 			"gateway_url": schema.StringAttribute{
-				Description: "The URL of the Tyk Gateway instance.",
-				Required:    true,
+				Description: "The URL of the Tyk Gateway instance. May also be set with the TYK_GATEWAY_URL environment variable.",
+				Optional:    true,
+			},
+			"gateway_urls": schema.ListAttribute{
+				Description: "The URLs of all Tyk Gateway instances of a cluster without a dashboard. " +
+					"Writes go to every instance and are rolled back when one of them fails, instances that diverge show up as drift. " +
+					"Conflicts with gateway_url. May also be set with the TYK_GATEWAY_URLS environment variable, separated by commas.",
+				ElementType: types.StringType,
+				Optional:    true,
 			},
 			"api_key": schema.StringAttribute{
-				Description: "The API key for authenticating with the Tyk Gateway instance.",
+				Description: "The API key for authenticating with the Tyk Gateway instance. May also be set with the TYK_GATEWAY_SECRET environment variable.",
 				Sensitive:   true,
-				Required:    true,
+				Optional:    true,
+			},
+			"max_retries": schema.Int64Attribute{
+				Description: "The number of times an idempotent request is retried after a transient failure. May also be set with the TYK_GATEWAY_MAX_RETRIES environment variable. Defaults to 3.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"min_backoff": schema.StringAttribute{
+				Description: "The delay before the first retry, as a duration such as \"500ms\". Doubles on every further retry. May also be set with the TYK_GATEWAY_MIN_BACKOFF environment variable. Defaults to 1s.",
+				Optional:    true,
+			},
+			"max_backoff": schema.StringAttribute{
+				Description: "The upper bound of the delay between retries, as a duration such as \"30s\". May also be set with the TYK_GATEWAY_MAX_BACKOFF environment variable. Defaults to 30s.",
+				Optional:    true,
+			},
+			"ca_cert": schema.StringAttribute{
+				Description: "The PEM encoded CA certificate, or a path to it, used to verify the gateway certificate. May also be set with the TYK_GATEWAY_CA_CERT environment variable.",
+				Optional:    true,
+			},
+			"client_cert": schema.StringAttribute{
+				Description: "The PEM encoded client certificate, or a path to it, for mutual TLS. May also be set with the TYK_GATEWAY_CLIENT_CERT environment variable.",
+				Optional:    true,
+			},
+			"client_key": schema.StringAttribute{
+				Description: "The PEM encoded client private key, or a path to it, for mutual TLS. May also be set with the TYK_GATEWAY_CLIENT_KEY environment variable.",
+				Sensitive:   true,
+				Optional:    true,
+			},
+			"tls_server_name": schema.StringAttribute{
+				Description: "The server name to verify the gateway certificate against, when it differs from the gateway_url host. May also be set with the TYK_GATEWAY_TLS_SERVER_NAME environment variable.",
+				Optional:    true,
+			},
+			"insecure_skip_verify": schema.BoolAttribute{
+				Description: "Disables verification of the gateway certificate. Only use this for testing. May also be set with the TYK_GATEWAY_INSECURE_SKIP_VERIFY environment variable.",
+				Optional:    true,
+			},
+			"hot_reload": schema.StringAttribute{
+				Description: "When to reload the gateway group after changes to APIs and policies, which only take effect after a reload. " +
+					"One of \"off\" or \"per_change\", which reloads once changes have settled for a second, so that the changes Terraform applies together share a reload. " +
+					"There is no \"end_of_apply\" mode, since Terraform does not tell providers when an apply ends. " +
+					"Unless it is \"off\", an API or policy the gateway does not find, or finds changed, is read again after a blocking reload before this counts as a change outside of Terraform, since the gateway serves what it loaded last. " +
+					"When it is \"off\", an API or policy the gateway does not find is kept in the state with a warning. " +
+					"May also be set with the TYK_GATEWAY_HOT_RELOAD environment variable. Defaults to \"off\".",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(hotReloadModes()...),
+				},
+			},
+			"hot_reload_wait": schema.BoolAttribute{
+				Description: "Waits until the gateways have finished reloading, not only until they have been asked to reload. " +
+					"May also be set with the TYK_GATEWAY_HOT_RELOAD_WAIT environment variable.",
+				Optional: true,
+			},
+			"ready_timeout": schema.StringAttribute{
+				Description: "How long to poll the gateway health check at /hello before the first request, as a duration such as \"2m\". " +
+					"Useful when the gateway may still be starting. May also be set with the TYK_GATEWAY_READY_TIMEOUT environment variable. Defaults to not waiting.",
+				Optional: true,
 			},
 		},
 	}
@@ -67,6 +157,22 @@ func (p *tykgatewayProvider) Configure(ctx context.Context, req provider.Configu
 		)
 	}
 
+	if config.GatewayUrls.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("gateway_urls"),
+			"Unknown Tyk Gateway urls",
+			"The provider cannot create the Tyk Gateway API client as there is an unknown configuration value for the Tyk Gateway urls.",
+		)
+	}
+
+	if !config.GatewayUrl.IsNull() && !config.GatewayUrls.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("gateway_urls"),
+			"Conflicting Tyk Gateway urls",
+			"Only one of gateway_url and gateway_urls may be set.",
+		)
+	}
+
 	if config.ApiKey.IsUnknown() {
 		resp.Diagnostics.AddAttributeError(
 			path.Root("api_key"),
@@ -79,7 +185,47 @@ func (p *tykgatewayProvider) Configure(ctx context.Context, req provider.Configu
 		return
 	}
 
+	// Unset attributes fall back to environment variables.
+	config.GatewayUrl = stringFromEnv(config.GatewayUrl, "TYK_GATEWAY_URL")
+	if config.GatewayUrl.IsNull() {
+		config.GatewayUrls = listFromEnv(config.GatewayUrls, "TYK_GATEWAY_URLS")
+	}
+	config.ApiKey = stringFromEnv(config.ApiKey, "TYK_GATEWAY_SECRET")
+	config.MaxRetries = int64FromEnv(config.MaxRetries, "TYK_GATEWAY_MAX_RETRIES", path.Root("max_retries"), &resp.Diagnostics)
+	config.MinBackoff = stringFromEnv(config.MinBackoff, "TYK_GATEWAY_MIN_BACKOFF")
+	config.MaxBackoff = stringFromEnv(config.MaxBackoff, "TYK_GATEWAY_MAX_BACKOFF")
+	config.CACert = stringFromEnv(config.CACert, "TYK_GATEWAY_CA_CERT")
+	config.ClientCert = stringFromEnv(config.ClientCert, "TYK_GATEWAY_CLIENT_CERT")
+	config.ClientKey = stringFromEnv(config.ClientKey, "TYK_GATEWAY_CLIENT_KEY")
+	config.TLSServerName = stringFromEnv(config.TLSServerName, "TYK_GATEWAY_TLS_SERVER_NAME")
+	config.InsecureSkipVerify = boolFromEnv(config.InsecureSkipVerify, "TYK_GATEWAY_INSECURE_SKIP_VERIFY", path.Root("insecure_skip_verify"), &resp.Diagnostics)
+	config.HotReload = stringFromEnv(config.HotReload, "TYK_GATEWAY_HOT_RELOAD")
+	config.HotReloadWait = boolFromEnv(config.HotReloadWait, "TYK_GATEWAY_HOT_RELOAD_WAIT", path.Root("hot_reload_wait"), &resp.Diagnostics)
+	config.ReadyTimeout = stringFromEnv(config.ReadyTimeout, "TYK_GATEWAY_READY_TIMEOUT")
+
+	// Values from the environment skip the schema validators.
+	if !config.HotReload.IsNull() && !slices.Contains(hotReloadModes(), config.HotReload.ValueString()) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("hot_reload"),
+			"Invalid Tyk Gateway hot reload mode",
+			"Expected one of "+strings.Join(hotReloadModes(), ", ")+", got: "+config.HotReload.ValueString(),
+		)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var gatewayUrls []string
+	resp.Diagnostics.Append(config.GatewayUrls.ElementsAs(ctx, &gatewayUrls, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	gatewayUrl := config.GatewayUrl.ValueString()
+	if len(gatewayUrls) > 0 {
+		gatewayUrl = gatewayUrls[0]
+	}
 	apiKey := config.ApiKey.ValueString()
 
 	// If any of the expected configurations are missing, return
@@ -89,7 +235,8 @@ func (p *tykgatewayProvider) Configure(ctx context.Context, req provider.Configu
 		resp.Diagnostics.AddAttributeError(
 			path.Root("gateway_url"),
 			"Unknown Tyk Gateway url",
-			"The provider cannot create the Tyk Gateway API client as there is an unknown configuration value for the Tyk Gateway url.",
+			"The provider cannot create the Tyk Gateway API client as there is an unknown configuration value for the Tyk Gateway url. "+
+				"Set gateway_url in the configuration or use the TYK_GATEWAY_URL environment variable.",
 		)
 	}
 
@@ -97,7 +244,8 @@ func (p *tykgatewayProvider) Configure(ctx context.Context, req provider.Configu
 		resp.Diagnostics.AddAttributeError(
 			path.Root("api_key"),
 			"Unknown Tyk Gateway API Key",
-			"The provider cannot create the Tyk Gateway API client as there is an unknown configuration value for the Tyk Gateway api_key. ",
+			"The provider cannot create the Tyk Gateway API client as there is an unknown configuration value for the Tyk Gateway api_key. "+
+				"Set api_key in the configuration or use the TYK_GATEWAY_SECRET environment variable.",
 		)
 	}
 
@@ -105,13 +253,31 @@ func (p *tykgatewayProvider) Configure(ctx context.Context, req provider.Configu
 		return
 	}
 
+	tlsConfig := client.TLSConfig{
+		CACert:             readPemAttribute(config.CACert, path.Root("ca_cert"), &resp.Diagnostics),
+		ClientCert:         readPemAttribute(config.ClientCert, path.Root("client_cert"), &resp.Diagnostics),
+		ClientKey:          readPemAttribute(config.ClientKey, path.Root("client_key"), &resp.Diagnostics),
+		ServerName:         config.TLSServerName.ValueString(),
+		InsecureSkipVerify: config.InsecureSkipVerify.ValueBool(),
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	ctx = tflog.SetField(ctx, "gateway url", gatewayUrl)
 	ctx = tflog.SetField(ctx, "api_key", apiKey)
 	tflog.MaskFieldValuesWithFieldKeys(ctx, "api_key")
 
 	tflog.Debug(ctx, "Creating TykGateway client")
 
-	client, err := client.NewClient(gatewayUrl, apiKey)
+	var tykClient *client.Client
+	var err error
+	if len(gatewayUrls) > 1 {
+		tykClient, err = client.NewClusterClient(gatewayUrls, apiKey)
+	} else {
+		tykClient, err = client.NewClient(gatewayUrl, apiKey)
+	}
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error creating Tyk Gateway client",
@@ -123,8 +289,63 @@ func (p *tykgatewayProvider) Configure(ctx context.Context, req provider.Configu
 		return
 	}
 
-	resp.DataSourceData = client
-	resp.ResourceData = client
+	if !config.MaxRetries.IsNull() {
+		tykClient.MaxRetries = int(config.MaxRetries.ValueInt64())
+	}
+	if !config.MinBackoff.IsNull() {
+		tykClient.MinBackoff = parseDurationAttribute(config.MinBackoff, path.Root("min_backoff"), &resp.Diagnostics)
+	}
+	if !config.MaxBackoff.IsNull() {
+		tykClient.MaxBackoff = parseDurationAttribute(config.MaxBackoff, path.Root("max_backoff"), &resp.Diagnostics)
+	}
+	if tykClient.MinBackoff > tykClient.MaxBackoff {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("min_backoff"),
+			"Invalid Tyk Gateway retry backoff",
+			"The min_backoff "+tykClient.MinBackoff.String()+" exceeds the max_backoff "+tykClient.MaxBackoff.String()+".",
+		)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if tlsConfig != (client.TLSConfig{}) {
+		err = tykClient.SetTLSConfig(tlsConfig)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Invalid Tyk Gateway TLS configuration",
+				"Could not configure TLS for the Tyk Gateway client: "+err.Error(),
+			)
+			return
+		}
+	}
+
+	if !config.ReadyTimeout.IsNull() {
+		readyTimeout := parseDurationAttribute(config.ReadyTimeout, path.Root("ready_timeout"), &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		tflog.Debug(ctx, "Waiting for TykGateway to be ready")
+		err = tykClient.WaitForReadyContext(ctx, readyTimeout)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Tyk Gateway not ready",
+				"The Tyk Gateway health check did not pass: "+err.Error(),
+			)
+			return
+		}
+	}
+
+	hotReload := client.ReloadMode(config.HotReload.ValueString())
+	if hotReload != "" && hotReload != client.ReloadOff {
+		tykClient.Reloader = client.NewReloader(tykClient, hotReload)
+		tykClient.Reloader.Wait = config.HotReloadWait.ValueBool()
+	}
+
+	resp.DataSourceData = tykClient
+	resp.ResourceData = tykClient
 	tflog.Debug(ctx, "TykGateway client created successfully", map[string]interface{}{
 		"gateway_url": gatewayUrl,
 	})
@@ -135,11 +356,112 @@ func (p *tykgatewayProvider) Metadata(ctx context.Context, req provider.Metadata
 }
 
 func (p *tykgatewayProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
-	return []func() datasource.DataSource{}
+	return []func() datasource.DataSource{
+		NewKeyDataSource,
+	}
 }
 
 func (p *tykgatewayProvider) Resources(ctx context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		NewKeyResource,
+		NewApiResource,
+		NewOasApiResource,
+		NewPolicyResource,
+		NewCertificateResource,
+		NewOAuthClientResource,
+		NewOrgKeyResource,
+	}
+}
+
+func parseDurationAttribute(value types.String, attributePath path.Path, diags *diag.Diagnostics) time.Duration {
+	duration, err := time.ParseDuration(value.ValueString())
+	if err != nil || duration < 0 {
+		diags.AddAttributeError(
+			attributePath,
+			"Invalid duration",
+			"Expected a non-negative duration such as \"500ms\" or \"30s\", got: "+value.ValueString(),
+		)
+		return 0
+	}
+	return duration
+}
+
+// readPemAttribute returns the PEM value of an attribute that holds either PEM
+// data or a path to a PEM file.
+func readPemAttribute(value types.String, attributePath path.Path, diags *diag.Diagnostics) string {
+	if value.IsNull() || strings.Contains(value.ValueString(), "-----BEGIN") {
+		return value.ValueString()
+	}
+
+	pem, err := os.ReadFile(value.ValueString())
+	if err != nil {
+		diags.AddAttributeError(
+			attributePath,
+			"Invalid PEM file",
+			"Could not read PEM file: "+err.Error(),
+		)
+		return ""
+	}
+	return string(pem)
+}
+
+// stringFromEnv returns the value of the environment variable name when value
+// is unset.
+func stringFromEnv(value types.String, name string) types.String {
+	env, ok := os.LookupEnv(name)
+	if !value.IsNull() || !ok {
+		return value
+	}
+	return types.StringValue(env)
+}
+
+func int64FromEnv(value types.Int64, name string, attributePath path.Path, diags *diag.Diagnostics) types.Int64 {
+	env, ok := os.LookupEnv(name)
+	if !value.IsNull() || !ok {
+		return value
+	}
+
+	parsed, err := strconv.ParseInt(env, 10, 64)
+	if err != nil {
+		diags.AddAttributeError(
+			attributePath,
+			"Invalid environment variable",
+			"Expected an integer in "+name+", got: "+env,
+		)
+		return value
+	}
+	return types.Int64Value(parsed)
+}
+
+func boolFromEnv(value types.Bool, name string, attributePath path.Path, diags *diag.Diagnostics) types.Bool {
+	env, ok := os.LookupEnv(name)
+	if !value.IsNull() || !ok {
+		return value
+	}
+
+	parsed, err := strconv.ParseBool(env)
+	if err != nil {
+		diags.AddAttributeError(
+			attributePath,
+			"Invalid environment variable",
+			"Expected a boolean in "+name+", got: "+env,
+		)
+		return value
+	}
+	return types.BoolValue(parsed)
+}
+
+// listFromEnv returns the comma separated values of the environment variable
+// name when value is unset.
+func listFromEnv(value types.List, name string) types.List {
+	env, ok := os.LookupEnv(name)
+	if !value.IsNull() || !ok || env == "" {
+		return value
+	}
+
+	var elements []attr.Value
+	for _, element := range strings.Split(env, ",") {
+		elements = append(elements, types.StringValue(strings.TrimSpace(element)))
 	}
+	return types.ListValueMust(types.StringType, elements)
 }
@@ -1,21 +1,34 @@
 package provider
 
 import (
+	"fmt"
+	"os"
+	"regexp"
+	"terraform-provider-tykgateway/internal/fakegateway"
+	"testing"
+
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
 )
 
-const (
+const testAccApiKey = "foo"
+
+var (
+	// testAccGateway is the in-memory gateway acceptance tests run against.
+	testAccGateway *fakegateway.Server
+
+	// testAccGatewayUrl is the URL of testAccGateway.
+	testAccGatewayUrl string
+
 	// providerConfig is a shared configuration to combine with the actual
-	// test configuration so the HashiCups client is properly configured.
-	// It is also possible to use the HASHICUPS_ environment variables instead,
-	// such as updating the Makefile and running the testing through that tool.
-	providerConfig = `
-		provider "tykgateway" {
-			gateway_url = "http://192.168.5.119/tyk-gateway"
-			api_key     = "foo"
-		}
-	`
+	// test configuration so the Tyk Gateway client is properly configured.
+	providerConfig string
+
+	// hotReloadProviderConfig is like providerConfig, but reloads the gateway
+	// after each change, for tests that need APIs or policies to be loaded.
+	hotReloadProviderConfig string
 )
 
 var (
@@ -27,3 +40,289 @@ var (
 		"tykgateway": providerserver.NewProtocol6WithError(New()()),
 	}
 )
+
+func TestMain(m *testing.M) {
+	testAccGateway = fakegateway.New(testAccApiKey)
+	testAccGatewayUrl = testAccGateway.URL
+	providerConfig = `
+		provider "tykgateway" {
+			gateway_url = "` + testAccGatewayUrl + `"
+			api_key     = "` + testAccApiKey + `"
+		}
+	`
+	hotReloadProviderConfig = `
+		provider "tykgateway" {
+			gateway_url     = "` + testAccGatewayUrl + `"
+			api_key         = "` + testAccApiKey + `"
+			hot_reload      = "per_change"
+			hot_reload_wait = true
+		}
+	`
+
+	code := m.Run()
+	testAccGateway.Close()
+	os.Exit(code)
+}
+
+func TestAccProviderEnvironment(t *testing.T) {
+
+	t.Setenv("TF_ACC", "1")
+	t.Setenv("TYK_GATEWAY_URL", testAccGatewayUrl)
+	t.Setenv("TYK_GATEWAY_SECRET", testAccApiKey)
+	t.Setenv("TYK_GATEWAY_MAX_RETRIES", "1")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+provider "tykgateway" {}
+
+resource "tykgateway_policy" "policy1" {
+  name   = "Environment Policy"
+  org_id = "default"
+}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("tykgateway_policy.policy1", "policy_id"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccProviderMissingConfiguration(t *testing.T) {
+
+	t.Setenv("TF_ACC", "1")
+	t.Setenv("TYK_GATEWAY_URL", "")
+	t.Setenv("TYK_GATEWAY_SECRET", "")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+provider "tykgateway" {}
+
+resource "tykgateway_policy" "policy1" {
+  name   = "Environment Policy"
+  org_id = "default"
+}`,
+				ExpectError: regexp.MustCompile("Unknown Tyk Gateway url"),
+			},
+		},
+	})
+}
+
+func TestAccProviderHotReload(t *testing.T) {
+
+	t.Setenv("TF_ACC", "1")
+
+	reloads := testAccGateway.Reloads()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+provider "tykgateway" {
+  gateway_url     = "` + testAccGatewayUrl + `"
+  api_key         = "` + testAccApiKey + `"
+  hot_reload      = "per_change"
+  hot_reload_wait = true
+}
+
+resource "tykgateway_policy" "policy1" {
+  name   = "Hot Reload Policy"
+  org_id = "default"
+}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("tykgateway_policy.policy1", "policy_id"),
+					// A group reload, then a blocking one to wait for it.
+					func(s *terraform.State) error {
+						if got := testAccGateway.Reloads() - reloads; got != 2 {
+							return fmt.Errorf("expected 2 reloads, got %d", got)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func TestAccProviderHotReloadPerChange(t *testing.T) {
+
+	t.Setenv("TF_ACC", "1")
+
+	reloads := testAccGateway.Reloads()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+provider "tykgateway" {
+  gateway_url = "` + testAccGatewayUrl + `"
+  api_key     = "` + testAccApiKey + `"
+  hot_reload  = "per_change"
+}
+
+resource "tykgateway_policy" "policy1" {
+  name   = "Per Change Policy"
+  org_id = "default"
+}
+
+resource "tykgateway_policy" "policy2" {
+  name   = "Other Per Change Policy"
+  org_id = "default"
+}`,
+				Check: resource.ComposeTestCheckFunc(
+					// The changes applied together share a reload, which is
+					// done by the time the apply returns.
+					func(s *terraform.State) error {
+						if got := testAccGateway.Reloads() - reloads; got != 1 {
+							return fmt.Errorf("expected 1 reload, got %d", got)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func TestAccProviderHotReloadOff(t *testing.T) {
+
+	t.Setenv("TF_ACC", "1")
+
+	reloads := testAccGateway.Reloads()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// The gateway has not loaded the policy, which is kept in the
+			// state nevertheless.
+			{
+				Config: providerConfig + testAccPolicyConfig(1000),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("tykgateway_policy.policy1", "policy_id"),
+				),
+			},
+			{
+				Config:   providerConfig + testAccPolicyConfig(1000),
+				PlanOnly: true,
+			},
+		},
+	})
+
+	// Reading an object the gateway does not serve must not reload it.
+	if got := testAccGateway.Reloads() - reloads; got != 0 {
+		t.Errorf("expected no reloads, got %d", got)
+	}
+}
+
+func TestAccProviderInvalidHotReload(t *testing.T) {
+
+	t.Setenv("TF_ACC", "1")
+	t.Setenv("TYK_GATEWAY_HOT_RELOAD", "always")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+resource "tykgateway_policy" "policy1" {
+  name   = "Hot Reload Policy"
+  org_id = "default"
+}`,
+				ExpectError: regexp.MustCompile("Invalid Tyk Gateway hot reload mode"),
+			},
+		},
+	})
+}
+
+func TestAccProviderCluster(t *testing.T) {
+
+	t.Setenv("TF_ACC", "1")
+
+	second := fakegateway.New(testAccApiKey)
+	defer second.Close()
+
+	config := `
+provider "tykgateway" {
+  gateway_urls    = ["` + testAccGatewayUrl + `", "` + second.URL + `"]
+  api_key         = "` + testAccApiKey + `"
+  hot_reload      = "per_change"
+  hot_reload_wait = true
+}
+
+resource "tykgateway_policy" "policy1" {
+  name   = "Cluster Policy"
+  org_id = "default"
+  rate   = 1000
+}`
+	var policyId string
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("tykgateway_policy.policy1", "policy_id"),
+					func(s *terraform.State) error {
+						policyId = s.RootModule().Resources["tykgateway_policy.policy1"].Primary.Attributes["policy_id"]
+						for _, gateway := range []*fakegateway.Server{testAccGateway, second} {
+							if !gateway.HasPolicy(policyId) {
+								return fmt.Errorf("policy %s missing on gateway %s", policyId, gateway.URL)
+							}
+						}
+						return nil
+					},
+				),
+			},
+			// A node that diverges from the others shows up as drift.
+			{
+				PreConfig: func() {
+					second.UpdatePolicy(policyId, "rate", 5)
+				},
+				Config:             config,
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: config,
+			},
+			// A node that lacks the policy is an error, not a deleted policy.
+			{
+				PreConfig: func() {
+					second.DeletePolicy(policyId)
+				},
+				Config:      config,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`missing on some gateway\s+nodes`),
+			},
+			{
+				PreConfig: func() {
+					testAccGateway.DeletePolicy(policyId)
+				},
+				Config:             config,
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+// testAccImportStateId imports a resource by one of its attributes, since the
+// resources have no id attribute.
+func testAccImportStateId(resourceName string, attribute string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("resource not found: %s", resourceName)
+		}
+
+		return rs.Primary.Attributes[attribute], nil
+	}
+}